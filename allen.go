@@ -0,0 +1,210 @@
+package interval
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Relation is one of the 13 relations of Allen's interval algebra between
+// two non-empty intervals.
+type Relation int
+
+const (
+	// RelNone is the relation of an empty interval to any interval.
+	RelNone Relation = iota
+	// RelBefore means i ends before x begins, with a gap between them.
+	RelBefore
+	// RelMeets means i ends exactly where x begins, without a gap or a common
+	// point, such as [0, 2) and [2, 4).
+	RelMeets
+	// RelOverlaps means i begins before x and ends within x.
+	RelOverlaps
+	// RelFinishedBy means i begins before x and they end together.
+	RelFinishedBy
+	// RelContains means i begins before x and ends after x.
+	RelContains
+	// RelStarts means they begin together and i ends before x.
+	RelStarts
+	// RelEquals means they begin and end together.
+	RelEquals
+	// RelStartedBy means they begin together and i ends after x.
+	RelStartedBy
+	// RelDuring means i begins after x and ends before x.
+	RelDuring
+	// RelFinishes means i begins after x and they end together.
+	RelFinishes
+	// RelOverlappedBy means i begins within x and ends after x.
+	RelOverlappedBy
+	// RelMetBy means i begins exactly where x ends.
+	RelMetBy
+	// RelAfter means i begins after x ends, with a gap between them.
+	RelAfter
+)
+
+var relationNames = [...]string{
+	RelNone:         "none",
+	RelBefore:       "before",
+	RelMeets:        "meets",
+	RelOverlaps:     "overlaps",
+	RelFinishedBy:   "finished-by",
+	RelContains:     "contains",
+	RelStarts:       "starts",
+	RelEquals:       "equals",
+	RelStartedBy:    "started-by",
+	RelDuring:       "during",
+	RelFinishes:     "finishes",
+	RelOverlappedBy: "overlapped-by",
+	RelMetBy:        "met-by",
+	RelAfter:        "after",
+}
+
+func (r Relation) String() string {
+	if r < 0 || int(r) >= len(relationNames) {
+		return "Relation(" + strconv.Itoa(int(r)) + ")"
+	}
+	return relationNames[r]
+}
+
+// Inverse returns the relation of x to i if r is the relation of i to x.
+func (r Relation) Inverse() Relation {
+	if r <= RelNone || r > RelAfter {
+		return r
+	}
+	return RelAfter + RelBefore - r
+}
+
+// Relation returns the relation of receiver interval to x interval, taking
+// inclusive and exclusive endpoints into account: [0, 2] and [2, 4] have a
+// common point so they overlap, [0, 2) and (2, 4] have a gap so one is
+// before the other.
+func (i Interval[T]) Relation(x Interval[T]) Relation {
+	if i.IsEmpty() || x.IsEmpty() {
+		return RelNone
+	}
+	switch {
+	case i.LtBeginOf(x):
+		if i.Adjoin(x).IsEmpty() {
+			return RelBefore
+		}
+		return RelMeets
+	case x.LtBeginOf(i):
+		if i.Adjoin(x).IsEmpty() {
+			return RelAfter
+		}
+		return RelMetBy
+	}
+	return [3][3]Relation{
+		{RelOverlaps, RelFinishedBy, RelContains},
+		{RelStarts, RelEquals, RelStartedBy},
+		{RelDuring, RelFinishes, RelOverlappedBy},
+	}[compareBegin(i, x)+1][compareEnd(i, x)+1]
+}
+
+// Before returns true if receiver interval ends before x interval begins,
+// with a gap between them. Use x.Before(i) for the inverse relation.
+func (i Interval[T]) Before(x Interval[T]) bool {
+	return i.Relation(x) == RelBefore
+}
+
+// Meets returns true if receiver interval ends exactly where x interval
+// begins. Use x.Meets(i) for the inverse relation.
+func (i Interval[T]) Meets(x Interval[T]) bool {
+	return i.Relation(x) == RelMeets
+}
+
+// OverlapsAllen returns true if receiver interval begins before x interval
+// and ends within it, it is Allen's overlaps relation. Unlike the Overlaps
+// methods of sets and boxes, it is false for intervals that merely have a
+// common point. Use x.OverlapsAllen(i) for the inverse relation.
+func (i Interval[T]) OverlapsAllen(x Interval[T]) bool {
+	return i.Relation(x) == RelOverlaps
+}
+
+// Starts returns true if receiver interval begins together with x interval
+// and ends before it. Use x.Starts(i) for the inverse relation.
+func (i Interval[T]) Starts(x Interval[T]) bool {
+	return i.Relation(x) == RelStarts
+}
+
+// During returns true if receiver interval begins after x interval and
+// ends before it. Use x.During(i) for the inverse relation.
+func (i Interval[T]) During(x Interval[T]) bool {
+	return i.Relation(x) == RelDuring
+}
+
+// Finishes returns true if receiver interval begins after x interval and
+// ends together with it. Use x.Finishes(i) for the inverse relation.
+func (i Interval[T]) Finishes(x Interval[T]) bool {
+	return i.Relation(x) == RelFinishes
+}
+
+// RelationSet is a set of relations, a bit for each relation.
+type RelationSet uint16
+
+// Relations returns a relation set containing rs.
+func Relations(rs ...Relation) RelationSet {
+	var set RelationSet
+	for _, r := range rs {
+		set |= 1 << r
+	}
+	return set
+}
+
+// Has returns true if r is in this relation set.
+func (s RelationSet) Has(r Relation) bool {
+	return s&(1<<r) != 0
+}
+
+// Relations returns the relations in this relation set in order.
+func (s RelationSet) Relations() []Relation {
+	var rs []Relation
+	for r := RelBefore; r <= RelAfter; r++ {
+		if s.Has(r) {
+			rs = append(rs, r)
+		}
+	}
+	return rs
+}
+
+func (s RelationSet) String() string {
+	var b strings.Builder
+	b.WriteByte('{')
+	for n, r := range s.Relations() {
+		if n > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(r.String())
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// Compose returns the possible relations of i to k if r is the relation of
+// i to j and s is the relation of j to k, it is Allen's composition table.
+func Compose(r, s Relation) RelationSet {
+	if r <= RelNone || r > RelAfter || s <= RelNone || s > RelAfter {
+		return 0
+	}
+	return composition[r][s]
+}
+
+// composition is computed from every triple of intervals [a, b) with
+// endpoints in 0..6, which is enough points to realise every configuration
+// of three intervals.
+var composition = func() (table [RelAfter + 1][RelAfter + 1]RelationSet) {
+	var intervals []Interval[int]
+	for a := 0; a <= 6; a++ {
+		for b := a + 1; b <= 6; b++ {
+			intervals = append(intervals, Interval[int]{Begin: a, IncBegin: true, End: b})
+		}
+	}
+	for _, i := range intervals {
+		for _, j := range intervals {
+			r := i.Relation(j)
+			for _, k := range intervals {
+				table[r][j.Relation(k)] |= 1 << i.Relation(k)
+			}
+		}
+	}
+	return table
+}()
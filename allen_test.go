@@ -0,0 +1,178 @@
+package interval
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestInterval_Relation(t *testing.T) {
+	var relationCases = []struct {
+		i string
+		x string
+		w Relation
+	}{
+		{ // 0
+			i: "",
+			x: "===",
+			w: RelNone,
+		},
+		{ // 1
+			i: "==",
+			x: "   ==",
+			w: RelBefore,
+		},
+		{ // 2
+			i: "==*",
+			x: "  *==",
+			w: RelBefore,
+		},
+		{ // 3
+			i: "==*",
+			x: "  ==",
+			w: RelMeets,
+		},
+		{ // 4
+			i: "===",
+			x: "  *==",
+			w: RelMeets,
+		},
+		{ // 5
+			i: "===",
+			x: "  ==",
+			w: RelOverlaps,
+		},
+		{ // 6
+			i: "====",
+			x: " *==",
+			w: RelFinishedBy,
+		},
+		{ // 7
+			i: "<====",
+			x: " ==*",
+			w: RelContains,
+		},
+		{ // 8
+			i: "==*",
+			x: "===",
+			w: RelStarts,
+		},
+		{ // 9
+			i: "*==>",
+			x: "*==>",
+			w: RelEquals,
+		},
+		{ // 10
+			i: "<===",
+			x: "<==",
+			w: RelStartedBy,
+		},
+		{ // 11
+			i: "*=*",
+			x: "===",
+			w: RelDuring,
+		},
+		{ // 12
+			i: " ==",
+			x: "===",
+			w: RelFinishes,
+		},
+		{ // 13
+			i: "  ==>",
+			x: "===",
+			w: RelOverlappedBy,
+		},
+		{ // 14
+			i: "  *==",
+			x: "===",
+			w: RelMetBy,
+		},
+		{ // 15
+			i: "   ==",
+			x: "<=*",
+			w: RelAfter,
+		},
+	}
+	for n, tc := range relationCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			i := parseInterval(tc.i)
+			x := parseInterval(tc.x)
+			if r := i.Relation(x); r != tc.w {
+				t.Errorf("want %s.Relation(%s) = %s but get %s", i, x, tc.w, r)
+			}
+			if r := x.Relation(i); r != tc.w.Inverse() {
+				t.Errorf("want %s.Relation(%s) = %s but get %s", x, i, tc.w.Inverse(), r)
+			}
+			for _, p := range []struct {
+				f func(Interval[int]) bool
+				r Relation
+			}{
+				{i.Before, RelBefore},
+				{i.Meets, RelMeets},
+				{i.OverlapsAllen, RelOverlaps},
+				{i.Starts, RelStarts},
+				{i.During, RelDuring},
+				{i.Finishes, RelFinishes},
+			} {
+				if p.f(x) != (tc.w == p.r) {
+					t.Errorf("want %s.%s(%s) = %t", i, p.r, x, tc.w == p.r)
+				}
+			}
+		})
+	}
+}
+
+func TestCompose(t *testing.T) {
+	var all []Relation
+	for r := RelBefore; r <= RelAfter; r++ {
+		all = append(all, r)
+	}
+	var composeCases = []struct {
+		r, s Relation
+		w    RelationSet
+	}{
+		{RelBefore, RelBefore, Relations(RelBefore)},
+		{RelMeets, RelMeets, Relations(RelBefore)},
+		{RelOverlaps, RelOverlaps, Relations(RelBefore, RelMeets, RelOverlaps)},
+		{RelStarts, RelDuring, Relations(RelDuring)},
+		{RelDuring, RelContains, Relations(all...)},
+		{RelEquals, RelFinishes, Relations(RelFinishes)},
+		{RelMeets, RelMetBy, Relations(RelFinishedBy, RelEquals, RelFinishes)},
+		{RelNone, RelBefore, 0},
+	}
+	for _, tc := range composeCases {
+		if c := Compose(tc.r, tc.s); c != tc.w {
+			t.Errorf("want Compose(%s, %s) = %s but get %s", tc.r, tc.s, tc.w, c)
+		}
+	}
+}
+
+func TestCompose_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randInterval := func() Interval[int] {
+		b := r.Intn(8)
+		return Interval[int]{Begin: b, IncBegin: r.Intn(2) == 0, End: b + r.Intn(4), IncEnd: r.Intn(2) == 0}
+	}
+	for n := 0; n < 20000; n++ {
+		i, j, k := randInterval(), randInterval(), randInterval()
+		if i.IsEmpty() || j.IsEmpty() || k.IsEmpty() {
+			continue
+		}
+		rij, rjk, rik := i.Relation(j), j.Relation(k), i.Relation(k)
+		if !Compose(rij, rjk).Has(rik) {
+			t.Fatalf("want %s in Compose(%s, %s) for %s, %s, %s", rik, rij, rjk, i, j, k)
+		}
+	}
+}
+
+func TestRelation_String(t *testing.T) {
+	if s := RelOverlappedBy.String(); s != "overlapped-by" {
+		t.Errorf("want overlapped-by but get %s", s)
+	}
+	if s := Relation(20).String(); s != "Relation(20)" {
+		t.Errorf("want Relation(20) but get %s", s)
+	}
+	if s := Relations(RelMeets, RelBefore).String(); s != "{before, meets}" {
+		t.Errorf("want {before, meets} but get %s", s)
+	}
+}
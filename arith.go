@@ -0,0 +1,296 @@
+package interval
+
+import (
+	"cmp"
+	"math"
+)
+
+// Float is a constraint that permits any floating-point endpoint type.
+type Float interface {
+	~float32 | ~float64
+}
+
+// The functions below implement interval arithmetic: the result of an
+// operation on intervals x and y is the set of results of the operation on
+// every point of x and every point of y. Inclusive and exclusive endpoints
+// are propagated, such as [1, 2) + [1, 1] = [2, 3), and unbounded endpoints
+// are treated as exclusive infinities. The result of an operation on an
+// empty interval is empty.
+
+// endpoint is the value and inclusiveness of a begin or an end.
+type endpoint[T Float] struct {
+	v   T
+	inc bool
+}
+
+// bounds returns the begin and the end of a non-empty interval, unbounded
+// endpoints are returned as exclusive infinities.
+func bounds[T Float](i Interval[T]) (endpoint[T], endpoint[T]) {
+	lo, hi := endpoint[T]{i.Begin, i.IncBegin}, endpoint[T]{i.End, i.IncEnd}
+	if i.UnboundedBegin {
+		lo = endpoint[T]{T(math.Inf(-1)), false}
+	}
+	if i.UnboundedEnd {
+		hi = endpoint[T]{T(math.Inf(1)), false}
+	}
+	return lo, hi
+}
+
+// fromBounds returns the interval between lo and hi, infinities become
+// unbounded endpoints.
+func fromBounds[T Float](lo, hi endpoint[T]) Interval[T] {
+	i := Interval[T]{Begin: lo.v, IncBegin: lo.inc, End: hi.v, IncEnd: hi.inc}
+	if math.IsInf(float64(lo.v), -1) {
+		i.Begin, i.IncBegin, i.UnboundedBegin = 0, false, true
+	}
+	if math.IsInf(float64(hi.v), 1) {
+		i.End, i.IncEnd, i.UnboundedEnd = 0, false, true
+	}
+	return maybeEmpty(i)
+}
+
+// AddIntervals returns the interval of x + y for every x in i and y in j.
+func AddIntervals[T Float](i, j Interval[T]) Interval[T] {
+	if i.IsEmpty() || j.IsEmpty() {
+		return Interval[T]{}
+	}
+	ilo, ihi := bounds(i)
+	jlo, jhi := bounds(j)
+	return fromBounds(
+		endpoint[T]{ilo.v + jlo.v, ilo.inc && jlo.inc},
+		endpoint[T]{ihi.v + jhi.v, ihi.inc && jhi.inc},
+	)
+}
+
+// NegInterval returns the interval of -x for every x in i.
+func NegInterval[T Float](i Interval[T]) Interval[T] {
+	if i.IsEmpty() {
+		return Interval[T]{}
+	}
+	lo, hi := bounds(i)
+	return fromBounds(endpoint[T]{-hi.v, hi.inc}, endpoint[T]{-lo.v, lo.inc})
+}
+
+// SubIntervals returns the interval of x - y for every x in i and y in j.
+func SubIntervals[T Float](i, j Interval[T]) Interval[T] {
+	return AddIntervals(i, NegInterval(j))
+}
+
+// MulIntervals returns the interval of x * y for every x in i and y in j.
+func MulIntervals[T Float](i, j Interval[T]) Interval[T] {
+	if i.IsEmpty() || j.IsEmpty() {
+		return Interval[T]{}
+	}
+	// 0 is reached by 0 * y even if the other endpoint is exclusive.
+	zero := i.Contains(point[T](0)) || j.Contains(point[T](0))
+	return corners(i, j, zero, func(x, y T) T {
+		if x == 0 || y == 0 {
+			// 0 * inf is the limit 0 and not NaN.
+			return 0
+		}
+		return x * y
+	})
+}
+
+// DivIntervals returns the set of x / y for every x in i and every non-zero y in j.
+// If j contains 0 the result may be two intervals, such as
+// [1, 1] / [-1, 1] = {(-inf, -1], [1, +inf)}.
+func DivIntervals[T Float](i, j Interval[T]) OrderedSet[T] {
+	if i.IsEmpty() || j.IsEmpty() {
+		return OrderedSet[T]{}
+	}
+	zero := i.Contains(point[T](0))
+	var pieces []Interval[T]
+	for _, part := range [2]struct {
+		interval Interval[T]
+		sign     T
+	}{
+		{j.Intersect(Interval[T]{UnboundedBegin: true, End: 0}), -1},
+		{j.Intersect(Interval[T]{Begin: 0, UnboundedEnd: true}), 1},
+	} {
+		if part.interval.IsEmpty() {
+			continue
+		}
+		pieces = append(pieces, corners(i, part.interval, zero, func(x, y T) T {
+			switch {
+			case x == 0:
+				return 0
+			case y == 0:
+				// y is an exclusive 0 on the side of sign.
+				return x * part.sign * T(math.Inf(1))
+			case math.IsInf(float64(x), 0) && math.IsInf(float64(y), 0):
+				// inf / inf is NaN, 0 is the limit of the neighbouring
+				// corner x / inf with a finite x.
+				return 0
+			}
+			return x / y
+		}))
+	}
+	return FromIntervals(pieces)
+}
+
+// corners returns the interval between the least and the greatest of
+// f(x, y) for the endpoints x of i and y of j, which are the extremes of
+// monotonic operations. An extreme is inclusive if it is reached from
+// inclusive endpoints, or it is 0 and zero is true.
+func corners[T Float](i, j Interval[T], zero bool, f func(x, y T) T) Interval[T] {
+	ilo, ihi := bounds(i)
+	jlo, jhi := bounds(j)
+	var lo, hi endpoint[T]
+	for n, c := range [4][2]endpoint[T]{{ilo, jlo}, {ilo, jhi}, {ihi, jlo}, {ihi, jhi}} {
+		p := endpoint[T]{f(c[0].v, c[1].v), c[0].inc && c[1].inc}
+		if p.v == 0 && zero {
+			p.inc = true
+		}
+		if n == 0 {
+			lo, hi = p, p
+			continue
+		}
+		switch {
+		case p.v < lo.v:
+			lo = p
+		case p.v == lo.v:
+			lo.inc = lo.inc || p.inc
+		}
+		switch {
+		case p.v > hi.v:
+			hi = p
+		case p.v == hi.v:
+			hi.inc = hi.inc || p.inc
+		}
+	}
+	return fromBounds(lo, hi)
+}
+
+// AbsInterval returns the interval of |x| for every x in i.
+func AbsInterval[T Float](i Interval[T]) Interval[T] {
+	if i.IsEmpty() {
+		return Interval[T]{}
+	}
+	lo, hi := bounds(i)
+	switch {
+	case lo.v >= 0:
+		return i
+	case hi.v <= 0:
+		return NegInterval(i)
+	}
+	end := hi
+	switch {
+	case -lo.v > hi.v:
+		end = endpoint[T]{-lo.v, lo.inc}
+	case -lo.v == hi.v:
+		end.inc = lo.inc || hi.inc
+	}
+	return fromBounds(endpoint[T]{0, true}, end)
+}
+
+// PowInterval returns the interval of x to the power n for every x in i,
+// divide [1, 1] by it with DivIntervals for negative powers.
+func PowInterval[T Float](i Interval[T], n uint) Interval[T] {
+	if i.IsEmpty() {
+		return Interval[T]{}
+	}
+	if n == 0 {
+		return point[T](1)
+	}
+	if n%2 == 0 {
+		i = AbsInterval(i)
+	}
+	// x to an odd power, and |x| to any power, is monotonic.
+	lo, hi := bounds(i)
+	pow := func(v T) T { return T(math.Pow(float64(v), float64(n))) }
+	return fromBounds(endpoint[T]{pow(lo.v), lo.inc}, endpoint[T]{pow(hi.v), hi.inc})
+}
+
+// MinIntervals returns the interval of min(x, y) for every x in i and y in j.
+func MinIntervals[T cmp.Ordered](i, j Interval[T]) Interval[T] {
+	if i.IsEmpty() || j.IsEmpty() {
+		return Interval[T]{}
+	}
+	if compareBegin(j, i) < 0 {
+		i.Begin, i.IncBegin, i.UnboundedBegin = j.Begin, j.IncBegin, j.UnboundedBegin
+	}
+	if compareEnd(j, i) < 0 {
+		i.End, i.IncEnd, i.UnboundedEnd = j.End, j.IncEnd, j.UnboundedEnd
+	}
+	return i
+}
+
+// MaxIntervals returns the interval of max(x, y) for every x in i and y in j.
+func MaxIntervals[T cmp.Ordered](i, j Interval[T]) Interval[T] {
+	if i.IsEmpty() || j.IsEmpty() {
+		return Interval[T]{}
+	}
+	if compareBegin(j, i) > 0 {
+		i.Begin, i.IncBegin, i.UnboundedBegin = j.Begin, j.IncBegin, j.UnboundedBegin
+	}
+	if compareEnd(j, i) > 0 {
+		i.End, i.IncEnd, i.UnboundedEnd = j.End, j.IncEnd, j.UnboundedEnd
+	}
+	return i
+}
+
+// AddSets returns the set of x + y for every x in a and y in b.
+func AddSets[T Float](a, b OrderedSet[T]) OrderedSet[T] {
+	return lift(a, b, AddIntervals[T])
+}
+
+// SubSets returns the set of x - y for every x in a and y in b.
+func SubSets[T Float](a, b OrderedSet[T]) OrderedSet[T] {
+	return lift(a, b, SubIntervals[T])
+}
+
+// MulSets returns the set of x * y for every x in a and y in b.
+func MulSets[T Float](a, b OrderedSet[T]) OrderedSet[T] {
+	return lift(a, b, MulIntervals[T])
+}
+
+// DivSets returns the set of x / y for every x in a and non-zero y in b.
+func DivSets[T Float](a, b OrderedSet[T]) OrderedSet[T] {
+	var pieces []Interval[T]
+	for _, i := range a.intervals {
+		for _, j := range b.intervals {
+			pieces = append(pieces, DivIntervals(i, j).intervals...)
+		}
+	}
+	return FromIntervals(pieces)
+}
+
+// MinSets returns the set of min(x, y) for every x in a and y in b.
+func MinSets[T cmp.Ordered](a, b OrderedSet[T]) OrderedSet[T] {
+	return lift(a, b, MinIntervals[T])
+}
+
+// MaxSets returns the set of max(x, y) for every x in a and y in b.
+func MaxSets[T cmp.Ordered](a, b OrderedSet[T]) OrderedSet[T] {
+	return lift(a, b, MaxIntervals[T])
+}
+
+// AbsSet returns the set of |x| for every x in s.
+func AbsSet[T Float](s OrderedSet[T]) OrderedSet[T] {
+	pieces := make([]Interval[T], 0, len(s.intervals))
+	for _, i := range s.intervals {
+		pieces = append(pieces, AbsInterval(i))
+	}
+	return FromIntervals(pieces)
+}
+
+// PowSet returns the set of x to the power n for every x in s.
+func PowSet[T Float](s OrderedSet[T], n uint) OrderedSet[T] {
+	pieces := make([]Interval[T], 0, len(s.intervals))
+	for _, i := range s.intervals {
+		pieces = append(pieces, PowInterval(i, n))
+	}
+	return FromIntervals(pieces)
+}
+
+// lift applies f to every pair of intervals of a and b.
+func lift[T cmp.Ordered](a, b OrderedSet[T], f func(i, j Interval[T]) Interval[T]) OrderedSet[T] {
+	pieces := make([]Interval[T], 0, len(a.intervals)*len(b.intervals))
+	for _, i := range a.intervals {
+		for _, j := range b.intervals {
+			pieces = append(pieces, f(i, j))
+		}
+	}
+	return FromIntervals(pieces)
+}
@@ -0,0 +1,190 @@
+package interval
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func mustParseInterval(s string) Interval[float64] {
+	i, err := ParseInterval[float64](s)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+func TestArithmetic(t *testing.T) {
+	var arithCases = []struct {
+		op   string
+		i, j string
+		w    string
+	}{
+		{"AddIntervals", "[1, 2)", "[1, 1]", "[2, 3)"},
+		{"AddIntervals", "(-inf, 2]", "(1, 3]", "(-inf, 5]"},
+		{"AddIntervals", "[1, 2]", "(-inf, +inf)", "(-inf, +inf)"},
+		{"SubIntervals", "[1, 2)", "(0, 1]", "[0, 2)"},
+		{"SubIntervals", "[1, 2]", "[1, +inf)", "(-inf, 1]"},
+		{"MulIntervals", "[1, 2)", "[3, 4]", "[3, 8)"},
+		{"MulIntervals", "(-1, 1]", "[2, 3]", "(-3, 3]"},
+		{"MulIntervals", "[0, 1]", "(2, 3)", "[0, 3)"},
+		{"MulIntervals", "(0, 1]", "(-2, 3)", "(-2, 3)"},
+		{"MulIntervals", "[0, 1]", "(2, +inf)", "[0, +inf)"},
+		{"MulIntervals", "(-inf, -1]", "[-2, -1)", "(1, +inf)"},
+		{"MulIntervals", "[-2, 3]", "[-5, 4]", "[-15, 12]"},
+		{"MinIntervals", "[1, 3)", "(2, 3]", "[1, 3)"},
+		{"MinIntervals", "[1, 2]", "[1, 2)", "[1, 2)"},
+		{"MaxIntervals", "[1, 3)", "(2, 3]", "(2, 3]"},
+		{"MaxIntervals", "(1, 2]", "[1, 2)", "(1, 2]"},
+		{"MaxIntervals", "(-inf, 2]", "[1, 2)", "[1, 2]"},
+		{"AddIntervals", "[1, 2]", "(3, 3)", "(0, 0)"},
+	}
+	ops := map[string]func(i, j Interval[float64]) Interval[float64]{
+		"AddIntervals": AddIntervals[float64],
+		"SubIntervals": SubIntervals[float64],
+		"MulIntervals": MulIntervals[float64],
+		"MinIntervals": MinIntervals[float64],
+		"MaxIntervals": MaxIntervals[float64],
+	}
+	for n, tc := range arithCases {
+		t.Run(fmt.Sprint(n, tc.op), func(t *testing.T) {
+			i, j, w := mustParseInterval(tc.i), mustParseInterval(tc.j), mustParseInterval(tc.w)
+			if r := ops[tc.op](i, j); !r.Equal(w) {
+				t.Errorf("want %s(%s, %s) = %s but get %s", tc.op, i, j, w, r)
+			}
+		})
+	}
+}
+
+func TestDivIntervals(t *testing.T) {
+	var divCases = []struct {
+		i, j string
+		w    string
+	}{
+		{"[1, 2]", "[1, 4]", "{[0.25, 2]}"},
+		{"[1, 2]", "(0, 4]", "{[0.25, +inf)}"},
+		{"[1, 1]", "[-1, 1]", "{(-inf, -1], [1, +inf)}"},
+		{"(1, 2]", "[-2, 0)", "{(-inf, -0.5)}"},
+		{"[-1, 1]", "[-1, 1]", "{(-inf, +inf)}"},
+		{"[1, 2]", "[0, 0]", "{}"},
+		{"[1, 2]", "(-inf, -1]", "{[-2, 0)}"},
+	}
+	for n, tc := range divCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			i, j := mustParseInterval(tc.i), mustParseInterval(tc.j)
+			w, err := ParseOrderedSet[float64](tc.w)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r := DivIntervals(i, j); !r.Equal(w) {
+				t.Errorf("want DivIntervals(%s, %s) = %s but get %s", i, j, w, r)
+			}
+		})
+	}
+}
+
+func TestAbsPowInterval(t *testing.T) {
+	var absPowCases = []struct {
+		i   string
+		abs string
+		n   uint
+		pow string
+	}{
+		{"[1, 2)", "[1, 2)", 2, "[1, 4)"},
+		{"(-3, -1]", "[1, 3)", 3, "(-27, -1]"},
+		{"(-3, 2]", "[0, 3)", 2, "[0, 9)"},
+		{"[-2, 2)", "[0, 2]", 2, "[0, 4]"},
+		{"(-inf, 1]", "[0, +inf)", 3, "(-inf, 1]"},
+		{"(-2, 1]", "[0, 2)", 0, "[1, 1]"},
+	}
+	for n, tc := range absPowCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			i := mustParseInterval(tc.i)
+			if a, w := AbsInterval(i), mustParseInterval(tc.abs); !a.Equal(w) {
+				t.Errorf("want AbsInterval(%s) = %s but get %s", i, w, a)
+			}
+			if p, w := PowInterval(i, tc.n), mustParseInterval(tc.pow); !p.Equal(w) {
+				t.Errorf("want PowInterval(%s, %d) = %s but get %s", i, tc.n, w, p)
+			}
+		})
+	}
+}
+
+func TestArithmetic_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randInterval := func() Interval[float64] {
+		b := float64(r.Intn(9) - 4)
+		return Interval[float64]{Begin: b, IncBegin: r.Intn(2) == 0, End: b + float64(r.Intn(4)), IncEnd: r.Intn(2) == 0}
+	}
+	// randPoint returns a point of a non-empty interval, often an endpoint.
+	randPoint := func(i Interval[float64]) float64 {
+		switch r.Intn(3) {
+		case 0:
+			if i.IncBegin {
+				return i.Begin
+			}
+		case 1:
+			if i.IncEnd {
+				return i.End
+			}
+		}
+		return i.Begin + (i.End-i.Begin)*(0.01+0.98*r.Float64())
+	}
+	for n := 0; n < 20000; n++ {
+		i, j := randInterval(), randInterval()
+		if i.IsEmpty() || j.IsEmpty() {
+			continue
+		}
+		x, y := randPoint(i), randPoint(j)
+		for _, c := range []struct {
+			op string
+			r  OrderedSet[float64]
+			v  float64
+		}{
+			{"AddIntervals", NewOrderedSet(AddIntervals(i, j)), x + y},
+			{"SubIntervals", NewOrderedSet(SubIntervals(i, j)), x - y},
+			{"MulIntervals", NewOrderedSet(MulIntervals(i, j)), x * y},
+			{"MinIntervals", NewOrderedSet(MinIntervals(i, j)), min(x, y)},
+			{"MaxIntervals", NewOrderedSet(MaxIntervals(i, j)), max(x, y)},
+			{"Abs", NewOrderedSet(AbsInterval(i)), max(x, -x)},
+			{"Pow", NewOrderedSet(PowInterval(i, 3)), x * x * x},
+		} {
+			if !c.r.ContainsPoint(c.v) {
+				t.Fatalf("want %s of %s and %s contains %v for %v and %v but get %s", c.op, i, j, c.v, x, y, c.r)
+			}
+		}
+		if y != 0 && !DivIntervals(i, j).ContainsPoint(x/y) {
+			t.Fatalf("want DivIntervals(%s, %s) contains %v but get %s", i, j, x/y, DivIntervals(i, j))
+		}
+	}
+}
+
+func TestArithmeticSets(t *testing.T) {
+	a, _ := ParseOrderedSet[float64]("{[0, 1), [2, 3]}")
+	b, _ := ParseOrderedSet[float64]("{[10, 10], (20, 21)}")
+	if s, w := AddSets(a, b), "{[10, 11), [12, 13], (20, 22), (22, 24)}"; s.String() != w {
+		t.Errorf("want AddSets(%s, %s) = %s but get %s", a, b, w, s)
+	}
+	if s, w := SubSets(b, a), "{[7, 8], (9, 10], (17, 19), (19, 21)}"; s.String() != w {
+		t.Errorf("want SubSets(%s, %s) = %s but get %s", b, a, w, s)
+	}
+	if s, w := MulSets(a, b), "{[0, 30], (40, 63)}"; s.String() != w {
+		t.Errorf("want MulSets(%s, %s) = %s but get %s", a, b, w, s)
+	}
+	if s, w := DivSets(b, a), "{[3.3333333333333335, 5], (6.666666666666667, +inf)}"; s.String() != w {
+		t.Errorf("want DivSets(%s, %s) = %s but get %s", b, a, w, s)
+	}
+	if s, w := MinSets(a, b), "{[0, 1), [2, 3]}"; s.String() != w {
+		t.Errorf("want MinSets(%s, %s) = %s but get %s", a, b, w, s)
+	}
+	if s, w := MaxSets(a, b), "{[10, 10], (20, 21)}"; s.String() != w {
+		t.Errorf("want MaxSets(%s, %s) = %s but get %s", a, b, w, s)
+	}
+	n := SubSets(a, NewOrderedSet(point[float64](2)))
+	if s, w := AbsSet(n), "{[0, 2]}"; s.String() != w {
+		t.Errorf("want AbsSet(%s) = %s but get %s", n, w, s)
+	}
+	if s, w := PowSet(n, 2), "{[0, 4]}"; s.String() != w {
+		t.Errorf("want PowSet(%s, 2) = %s but get %s", n, w, s)
+	}
+}
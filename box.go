@@ -0,0 +1,335 @@
+package interval
+
+import (
+	"cmp"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Box is a rectangle of the points (x, y) with x in X and y in Y.
+type Box[T cmp.Ordered] struct {
+	X, Y Interval[T]
+}
+
+func (b Box[T]) String() string {
+	return b.X.String() + " x " + b.Y.String()
+}
+
+// IsEmpty returns true if receiver box has no point.
+func (b Box[T]) IsEmpty() bool {
+	return b.X.IsEmpty() || b.Y.IsEmpty()
+}
+
+// Equal returns true if receiver box is equals x box.
+func (b Box[T]) Equal(x Box[T]) bool {
+	if b.IsEmpty() || x.IsEmpty() {
+		return b.IsEmpty() && x.IsEmpty()
+	}
+	return b.X.Equal(x.X) && b.Y.Equal(x.Y)
+}
+
+// Contains returns true if x box is completely covered by receiver box,
+// an empty box is covered by any box.
+func (b Box[T]) Contains(x Box[T]) bool {
+	if x.IsEmpty() {
+		return true
+	}
+	return b.X.Contains(x.X) && b.Y.Contains(x.Y)
+}
+
+// Intersect returns the intersection of receiver box with x box.
+func (b Box[T]) Intersect(x Box[T]) Box[T] {
+	i := Box[T]{b.X.Intersect(x.X), b.Y.Intersect(x.Y)}
+	if i.IsEmpty() {
+		return Box[T]{}
+	}
+	return i
+}
+
+// slab is the points of a box set with x in an interval, they have the same
+// y intervals.
+type slab[T cmp.Ordered] struct {
+	x Interval[T]
+	y OrderedSet[T]
+}
+
+// BoxSet is a set of points in two dimensions. It is kept as ordered and
+// non-overlapping slabs along the X axis, each with an ordered set along
+// the Y axis, and adjacent slabs with equal ordered sets are merged, so
+// every set of points has a single canonical decomposition into boxes.
+type BoxSet[T cmp.Ordered] struct {
+	slabs []slab[T]
+}
+
+// NewBoxSet returns a box set containing all of boxes.
+func NewBoxSet[T cmp.Ordered](boxes ...Box[T]) BoxSet[T] {
+	var s BoxSet[T]
+	for _, b := range boxes {
+		s.Add(b)
+	}
+	return s
+}
+
+// Copy returns a copy of a box set that without affecting the original.
+func (s BoxSet[T]) Copy() BoxSet[T] {
+	slabs := make([]slab[T], len(s.slabs))
+	for n, sl := range s.slabs {
+		slabs[n] = slab[T]{sl.x, sl.y.Copy()}
+	}
+	return BoxSet[T]{slabs}
+}
+
+// IsEmpty returns true if no points in this box set.
+func (s BoxSet[T]) IsEmpty() bool {
+	return len(s.slabs) == 0
+}
+
+func (s BoxSet[T]) Equal(x BoxSet[T]) bool {
+	if len(s.slabs) != len(x.slabs) {
+		return false
+	}
+	for n := range s.slabs {
+		if !s.slabs[n].x.Equal(x.slabs[n].x) || !s.slabs[n].y.Equal(x.slabs[n].y) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s BoxSet[T]) String() string {
+	var b strings.Builder
+	b.WriteByte('{')
+	for n, x := range s.Boxes() {
+		if n > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(x.String())
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// Boxes returns the canonical decomposition of this box set into
+// non-overlapping boxes, ordered by X and then by Y.
+func (s BoxSet[T]) Boxes() []Box[T] {
+	var boxes []Box[T]
+	for _, sl := range s.slabs {
+		for _, y := range sl.y.intervals {
+			boxes = append(boxes, Box[T]{sl.x, y})
+		}
+	}
+	return boxes
+}
+
+// Bound returns the box defined by the minimum and maximum values of this
+// box set on both axes.
+func (s BoxSet[T]) Bound() Box[T] {
+	if len(s.slabs) == 0 {
+		return Box[T]{}
+	}
+	var y Interval[T]
+	for _, sl := range s.slabs {
+		y = y.Encompass(sl.y.Bound())
+	}
+	return Box[T]{s.slabs[0].x.Encompass(s.slabs[len(s.slabs)-1].x), y}
+}
+
+// searchLow returns the first index in s.slabs that is not before x.
+func (s *BoxSet[T]) searchLow(x Interval[T]) int {
+	return sort.Search(len(s.slabs), func(i int) bool {
+		return !s.slabs[i].x.LtBeginOf(x)
+	})
+}
+
+// searchHigh returns the index of the first slab in s.slabs that is
+// entirely after x.
+func (s *BoxSet[T]) searchHigh(x Interval[T]) int {
+	return sort.Search(len(s.slabs), func(i int) bool {
+		return x.LtBeginOf(s.slabs[i].x)
+	})
+}
+
+// Contains returns true if x box is completely covered by this box set,
+// an empty box is covered by any box set.
+func (s BoxSet[T]) Contains(x Box[T]) bool {
+	if x.IsEmpty() {
+		return true
+	}
+	rest := x.X
+	for _, sl := range s.slabs[s.searchLow(x.X):s.searchHigh(x.X)] {
+		gap, after := rest.Bisect(sl.x)
+		if !gap.IsEmpty() || !sl.y.Contains(x.Y) {
+			return false
+		}
+		rest = after
+	}
+	return rest.IsEmpty()
+}
+
+// ContainsPoint returns true if the point (x, y) is in this box set.
+func (s BoxSet[T]) ContainsPoint(x, y T) bool {
+	return s.Contains(Box[T]{point(x), point(y)})
+}
+
+func appendSlab[T cmp.Ordered](slabs []slab[T], sl slab[T]) []slab[T] {
+	if n := len(slabs) - 1; n >= 0 && slabs[n].y.Equal(sl.y) {
+		if ad := slabs[n].x.Adjoin(sl.x); !ad.IsEmpty() {
+			slabs[n].x = ad
+			return slabs
+		}
+	}
+	return append(slabs, sl)
+}
+
+// update replaces the ordered sets of the portion of this box set covered
+// by x interval with the results of f, f is called with an empty ordered
+// set for the gaps between slabs.
+// update returns true if this box set changed.
+func (s *BoxSet[T]) update(x Interval[T], f func(y OrderedSet[T]) OrderedSet[T]) bool {
+	if x.IsEmpty() {
+		return false
+	}
+
+	low, high := s.searchLow(x), s.searchHigh(x)
+	slabs := make([]slab[T], 0, len(s.slabs)+high-low+2)
+	slabs = append(slabs, s.slabs[:low]...)
+	push := func(i Interval[T], y OrderedSet[T]) {
+		if !i.IsEmpty() && !y.IsEmpty() {
+			slabs = appendSlab(slabs, slab[T]{i, y})
+		}
+	}
+	changed := false
+	apply := func(i Interval[T], y OrderedSet[T]) {
+		if i.IsEmpty() {
+			return
+		}
+		fy := f(y)
+		if !fy.Equal(y) {
+			changed = true
+		}
+		push(i, fy)
+	}
+	rest := x
+	for _, sl := range s.slabs[low:high] {
+		left, right := sl.x.Bisect(x)
+		gap, after := rest.Bisect(sl.x)
+		push(left, sl.y)
+		apply(gap, OrderedSet[T]{})
+		apply(sl.x.Intersect(x), sl.y)
+		push(right, sl.y)
+		rest = after
+	}
+	apply(rest, OrderedSet[T]{})
+	if high < len(s.slabs) {
+		push(s.slabs[high].x, s.slabs[high].y)
+		slabs = append(slabs, s.slabs[high+1:]...)
+	}
+	s.slabs = slabs
+	return changed
+}
+
+// Add adds x box to this box set.
+// Add returns true if this box set changed.
+func (s *BoxSet[T]) Add(x Box[T]) bool {
+	if x.IsEmpty() {
+		return false
+	}
+	y := NewOrderedSet(x.Y)
+	return s.update(x.X, func(ys OrderedSet[T]) OrderedSet[T] {
+		return Union(ys, y)
+	})
+}
+
+// Remove removes x box from this box set.
+// Remove returns true if this box set changed.
+func (s *BoxSet[T]) Remove(x Box[T]) bool {
+	if x.IsEmpty() {
+		return false
+	}
+	y := NewOrderedSet(x.Y)
+	return s.update(x.X, func(ys OrderedSet[T]) OrderedSet[T] {
+		return Subtract(ys, y)
+	})
+}
+
+// Union returns a box set containing all points in s or x.
+func (s BoxSet[T]) Union(x BoxSet[T]) BoxSet[T] {
+	u := s.Copy()
+	for _, sl := range x.slabs {
+		u.update(sl.x, func(ys OrderedSet[T]) OrderedSet[T] {
+			return Union(ys, sl.y)
+		})
+	}
+	return u
+}
+
+// Intersect returns a box set containing all points of s that also belong to x.
+func (s BoxSet[T]) Intersect(x BoxSet[T]) BoxSet[T] {
+	var slabs []slab[T]
+	for i, j := 0, 0; i < len(s.slabs) && j < len(x.slabs); {
+		a, b := s.slabs[i], x.slabs[j]
+		if in := a.x.Intersect(b.x); !in.IsEmpty() {
+			if y := Intersect(a.y, b.y); !y.IsEmpty() {
+				slabs = appendSlab(slabs, slab[T]{in, y})
+			}
+		}
+		if compareEnd(a.x, b.x) <= 0 {
+			i++
+		} else {
+			j++
+		}
+	}
+	return BoxSet[T]{slabs}
+}
+
+// Subtract returns a box set containing all points in s but not in x.
+func (s BoxSet[T]) Subtract(x BoxSet[T]) BoxSet[T] {
+	d := s.Copy()
+	for _, sl := range x.slabs {
+		d.update(sl.x, func(ys OrderedSet[T]) OrderedSet[T] {
+			return Subtract(ys, sl.y)
+		})
+	}
+	return d
+}
+
+// Difference returns a box set containing all points in either of s and x,
+// but not in their intersection.
+func (s BoxSet[T]) Difference(x BoxSet[T]) BoxSet[T] {
+	return s.Subtract(x).Union(x.Subtract(s))
+}
+
+// Number is a constraint that permits any integer or floating-point
+// endpoint type.
+type Number interface {
+	Integer | Float
+}
+
+// width returns End - Begin of a non-empty interval, it is +Inf for an
+// unbounded interval.
+func width[T Number](i Interval[T]) float64 {
+	if i.UnboundedBegin || i.UnboundedEnd {
+		return math.Inf(1)
+	}
+	return float64(i.End) - float64(i.Begin)
+}
+
+// product returns a * b, it is 0 instead of NaN if one of them is 0 and the
+// other is infinite, such as a point on an unbounded line.
+func product(a, b float64) float64 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return a * b
+}
+
+// Area returns the total area of the boxes in s, it is +Inf if s has an
+// unbounded box of non-zero width on the other axis.
+func Area[T Number](s BoxSet[T]) float64 {
+	var area float64
+	for _, sl := range s.slabs {
+		area += product(width(sl.x), Length(sl.y))
+	}
+	return area
+}
@@ -0,0 +1,211 @@
+package interval
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// closedOpen returns the interval [begin, end).
+func closedOpen(begin, end int) Interval[int] {
+	return Interval[int]{Begin: begin, IncBegin: true, End: end}
+}
+
+func TestBox(t *testing.T) {
+	a := Box[int]{closedOpen(0, 4), closedOpen(0, 2)}
+	b := Box[int]{closedOpen(2, 6), closedOpen(1, 3)}
+	if w, i := (Box[int]{closedOpen(2, 4), closedOpen(1, 2)}), a.Intersect(b); !i.Equal(w) {
+		t.Errorf("want %s.Intersect(%s) = %s but get %s", a, b, w, i)
+	}
+	c := Box[int]{closedOpen(5, 6), closedOpen(0, 1)}
+	if i := a.Intersect(c); !i.IsEmpty() {
+		t.Errorf("want %s.Intersect(%s) empty but get %s", a, c, i)
+	}
+	if !a.Contains(Box[int]{closedOpen(1, 2), closedOpen(1, 2)}) || a.Contains(b) {
+		t.Errorf("want %s contains only the inner box", a)
+	}
+	if empty := (Box[int]{closedOpen(0, 0), closedOpen(100, 200)}); !a.Contains(empty) || !(Box[int]{}).Contains(empty) {
+		t.Errorf("want %s contained by any box", empty)
+	}
+	if w := "[0, 4) x [0, 2)"; a.String() != w {
+		t.Errorf("want %s but get %s", w, a)
+	}
+}
+
+func TestBoxSet(t *testing.T) {
+	a := Box[int]{closedOpen(0, 4), closedOpen(0, 2)}
+	b := Box[int]{closedOpen(2, 6), closedOpen(1, 3)}
+
+	var s BoxSet[int]
+	if !s.Add(a) || !s.Add(b) {
+		t.Fatalf("want Add changed %s", s)
+	}
+	if s.Add(Box[int]{closedOpen(3, 5), closedOpen(1, 2)}) {
+		t.Errorf("want Add of a covered box not changed %s", s)
+	}
+	if w := "{[0, 2) x [0, 2), [2, 4) x [0, 3), [4, 6) x [1, 3)}"; s.String() != w {
+		t.Errorf("want %s but get %s", w, s)
+	}
+	if !s.Equal(NewBoxSet(b, a)) {
+		t.Errorf("want %s equals %s", s, NewBoxSet(b, a))
+	}
+	if !s.Contains(Box[int]{closedOpen(1, 5), closedOpen(1, 2)}) {
+		t.Errorf("want %s contains a box across slabs", s)
+	}
+	if s.Contains(Box[int]{closedOpen(1, 5), closedOpen(0, 2)}) || s.Contains(Box[int]{closedOpen(5, 7), closedOpen(1, 2)}) {
+		t.Errorf("want %s not contains a box out of it", s)
+	}
+	if empty := (Box[int]{closedOpen(0, 0), closedOpen(100, 200)}); !s.Contains(empty) || !(BoxSet[int]{}).Contains(empty) {
+		t.Errorf("want %s contained by any box set", empty)
+	}
+	if w, b := (Box[int]{closedOpen(0, 6), closedOpen(0, 3)}), s.Bound(); !b.Equal(w) {
+		t.Errorf("want bound %s but get %s", w, b)
+	}
+	if area := Area(s); area != 14 {
+		t.Errorf("want area 14 but get %v", area)
+	}
+
+	if !s.Remove(Box[int]{closedOpen(0, 6), closedOpen(1, 2)}) {
+		t.Errorf("want Remove changed %s", s)
+	}
+	if w := "{[0, 2) x [0, 1), [2, 4) x [0, 1), [2, 4) x [2, 3), [4, 6) x [2, 3)}"; s.String() != w {
+		t.Errorf("want %s but get %s", w, s)
+	}
+	if s.Remove(Box[int]{closedOpen(7, 8), closedOpen(0, 8)}) {
+		t.Errorf("want Remove of a disjoint box not changed %s", s)
+	}
+	s.Remove(s.Bound())
+	if !s.IsEmpty() {
+		t.Errorf("want empty but get %s", s)
+	}
+
+	unbounded := NewBoxSet(Box[int]{Interval[int]{UnboundedBegin: true, End: 0}, closedOpen(0, 1)})
+	if area := Area(unbounded); !math.IsInf(area, 1) {
+		t.Errorf("want area +Inf but get %v", area)
+	}
+	// a slab of zero width has no area, even with an unbounded height.
+	line := NewBoxSet(Box[int]{point(0), Interval[int]{UnboundedBegin: true, UnboundedEnd: true}})
+	if area := Area(line); area != 0 {
+		t.Errorf("want area 0 but get %v", area)
+	}
+}
+
+func TestBoxSetOperations(t *testing.T) {
+	x := NewBoxSet(Box[int]{closedOpen(0, 4), closedOpen(0, 4)})
+	y := NewBoxSet(Box[int]{closedOpen(2, 6), closedOpen(2, 6)})
+
+	var operationCases = []struct {
+		name string
+		get  BoxSet[int]
+		w    string
+		area float64
+	}{
+		{
+			name: "union",
+			get:  x.Union(y),
+			w:    "{[0, 2) x [0, 4), [2, 4) x [0, 6), [4, 6) x [2, 6)}",
+			area: 28,
+		},
+		{
+			name: "intersect",
+			get:  x.Intersect(y),
+			w:    "{[2, 4) x [2, 4)}",
+			area: 4,
+		},
+		{
+			name: "subtract",
+			get:  x.Subtract(y),
+			w:    "{[0, 2) x [0, 4), [2, 4) x [0, 2)}",
+			area: 12,
+		},
+		{
+			name: "difference",
+			get:  x.Difference(y),
+			w:    "{[0, 2) x [0, 4), [2, 4) x [0, 2), [2, 4) x [4, 6), [4, 6) x [2, 6)}",
+			area: 24,
+		},
+	}
+	for _, tc := range operationCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.get.String() != tc.w {
+				t.Errorf("want %s but get %s", tc.w, tc.get)
+			}
+			if area := Area(tc.get); area != tc.area {
+				t.Errorf("want area %v but get %v", tc.area, area)
+			}
+		})
+	}
+}
+
+// TestBoxSetRandom compares box sets with a grid of the points with half
+// coordinates, which tells apart inclusive and exclusive endpoints.
+func TestBoxSetRandom(t *testing.T) {
+	const size = 8
+	r := rand.New(rand.NewSource(1))
+	randInterval := func() Interval[float64] {
+		a, b := float64(r.Intn(size)), float64(r.Intn(size))
+		return Interval[float64]{Begin: min(a, b), IncBegin: r.Intn(2) == 0, End: max(a, b), IncEnd: r.Intn(2) == 0}
+	}
+	randBox := func() Box[float64] {
+		return Box[float64]{randInterval(), randInterval()}
+	}
+	type grid [2 * size][2 * size]bool
+	fill := func(g *grid, b Box[float64], v bool) {
+		for i := range g {
+			for j := range g[i] {
+				if b.Contains(Box[float64]{point(float64(i) / 2), point(float64(j) / 2)}) {
+					g[i][j] = v
+				}
+			}
+		}
+	}
+	check := func(s BoxSet[float64], g grid) {
+		t.Helper()
+		for i := range g {
+			for j := range g[i] {
+				if x, y := float64(i)/2, float64(j)/2; s.ContainsPoint(x, y) != g[i][j] {
+					t.Fatalf("want ContainsPoint(%v, %v) = %v in %s", x, y, g[i][j], s)
+				}
+			}
+		}
+	}
+
+	for n := 0; n < 200; n++ {
+		var s, x BoxSet[float64]
+		var gs, gx grid
+		var boxes []Box[float64]
+		for k := 0; k < 6; k++ {
+			b := randBox()
+			boxes = append(boxes, b)
+			s.Add(b)
+			fill(&gs, b, true)
+			c := randBox()
+			x.Add(c)
+			fill(&gx, c, true)
+		}
+		check(s, gs)
+		for k := len(boxes) - 1; k >= 0; k-- {
+			// the decomposition is canonical, whatever the order of boxes.
+			if r := NewBoxSet(boxes[k:]...).Union(NewBoxSet(boxes[:k]...)); !r.Equal(s) {
+				t.Fatalf("want %s but get %s", s, r)
+			}
+		}
+
+		var union, intersect, subtract grid
+		for i := range gs {
+			for j := range gs[i] {
+				union[i][j] = gs[i][j] || gx[i][j]
+				intersect[i][j] = gs[i][j] && gx[i][j]
+				subtract[i][j] = gs[i][j] && !gx[i][j]
+			}
+		}
+		check(s.Union(x), union)
+		check(s.Intersect(x), intersect)
+		check(s.Subtract(x), subtract)
+
+		b := randBox()
+		s.Remove(b)
+		fill(&gs, b, false)
+		check(s, gs)
+	}
+}
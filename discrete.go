@@ -0,0 +1,148 @@
+package interval
+
+// Canonical returns the interval of the same integer points as i in the
+// half-open form [a, b), so intervals of the same points are Equal and
+// intervals of consecutive points Adjoin: [1, 2], [1, 3) and (0, 3) are
+// all [1, 3). An interval that ends at the maximum of T keeps an inclusive
+// end, since the maximum plus one is not representable.
+func Canonical[T Integer](i Interval[T]) Interval[T] {
+	if i.IsEmpty() {
+		return Interval[T]{}
+	}
+	if !i.UnboundedBegin && !i.IncBegin {
+		if i.Begin+1 < i.Begin {
+			// (max, ...) has no points.
+			return Interval[T]{}
+		}
+		i.Begin, i.IncBegin = i.Begin+1, true
+	}
+	if !i.UnboundedEnd {
+		switch {
+		case i.IncEnd && i.End+1 > i.End:
+			i.End, i.IncEnd = i.End+1, false
+		case !i.IncEnd && i.End-1 > i.End:
+			// (..., min) has no points.
+			return Interval[T]{}
+		}
+	}
+	// ignored fields of unbounded endpoints are cleared as well, so the
+	// canonical intervals of the same points are ==.
+	if i.UnboundedBegin {
+		i.Begin, i.IncBegin = 0, false
+	}
+	if i.UnboundedEnd {
+		i.End, i.IncEnd = 0, false
+	}
+	if i.IsEmpty() {
+		return Interval[T]{}
+	}
+	return i
+}
+
+// DiscreteSet is a set of ordered and non-overlapping intervals of integer
+// points. Every interval is converted by Canonical on entry, so [1, 2] and
+// [3, 4] are merged into [1, 5) and String prints the canonical form.
+type DiscreteSet[T Integer] struct {
+	set OrderedSet[T]
+}
+
+// NewDiscreteSet returns a discrete set containing all of intervals.
+func NewDiscreteSet[T Integer](intervals ...Interval[T]) DiscreteSet[T] {
+	canonical := make([]Interval[T], 0, len(intervals))
+	for _, i := range intervals {
+		canonical = append(canonical, Canonical(i))
+	}
+	return DiscreteSet[T]{FromIntervals(canonical)}
+}
+
+// Copy returns a copy of a discrete set that without affecting the original.
+func (s DiscreteSet[T]) Copy() DiscreteSet[T] {
+	return DiscreteSet[T]{s.set.Copy()}
+}
+
+// Len returns length of intervals in this discrete set.
+func (s DiscreteSet[T]) Len() int {
+	return s.set.Len()
+}
+
+// IsEmpty returns true if no intervals in this discrete set.
+func (s DiscreteSet[T]) IsEmpty() bool {
+	return s.set.IsEmpty()
+}
+
+func (s DiscreteSet[T]) Equal(x DiscreteSet[T]) bool {
+	return s.set.Equal(x.set)
+}
+
+func (s DiscreteSet[T]) String() string {
+	return s.set.String()
+}
+
+// OrderedSet returns an ordered set containing the canonical intervals of
+// this discrete set.
+func (s DiscreteSet[T]) OrderedSet() OrderedSet[T] {
+	return s.set.Copy()
+}
+
+// Bound returns the Interval defined by the minimum and maximum values of this discrete set.
+func (s DiscreteSet[T]) Bound() Interval[T] {
+	return s.set.Bound()
+}
+
+// Intervals returns a copy of intervals in this discrete set.
+func (s DiscreteSet[T]) Intervals() []Interval[T] {
+	return s.set.Intervals()
+}
+
+// Contains returns true if every integer point of x interval is in this
+// discrete set.
+func (s DiscreteSet[T]) Contains(x Interval[T]) bool {
+	return s.set.Contains(Canonical(x))
+}
+
+// ContainsPoint returns true if p is in this discrete set.
+func (s DiscreteSet[T]) ContainsPoint(p T) bool {
+	return s.set.ContainsPoint(p)
+}
+
+// Add adds x interval to this discrete set.
+// Add returns true if this discrete set changed.
+func (s *DiscreteSet[T]) Add(x Interval[T]) bool {
+	return s.set.Add(Canonical(x))
+}
+
+// Remove removes x interval from this discrete set.
+// Remove returns true if this discrete set changed.
+func (s *DiscreteSet[T]) Remove(x Interval[T]) bool {
+	return s.set.Remove(Canonical(x))
+}
+
+// Union returns a discrete set containing all intervals in s or x.
+func (s DiscreteSet[T]) Union(x DiscreteSet[T]) DiscreteSet[T] {
+	return DiscreteSet[T]{Union(s.set, x.set)}
+}
+
+// Intersect returns a discrete set containing all intervals of s that also belong to x.
+func (s DiscreteSet[T]) Intersect(x DiscreteSet[T]) DiscreteSet[T] {
+	return DiscreteSet[T]{Intersect(s.set, x.set)}
+}
+
+// Subtract returns a discrete set containing all intervals in s but not in x.
+func (s DiscreteSet[T]) Subtract(x DiscreteSet[T]) DiscreteSet[T] {
+	return DiscreteSet[T]{Subtract(s.set, x.set)}
+}
+
+// Difference returns a discrete set containing all intervals in either of s and x,
+// but not in their intersection.
+func (s DiscreteSet[T]) Difference(x DiscreteSet[T]) DiscreteSet[T] {
+	return DiscreteSet[T]{Difference(s.set, x.set)}
+}
+
+// Complement returns a discrete set containing all intervals in universe
+// but not in s.
+func (s DiscreteSet[T]) Complement(universe Interval[T]) DiscreteSet[T] {
+	// the gap after an interval that ends at the maximum of T is not
+	// canonical, the other results of set operations on canonical
+	// intervals are.
+	return NewDiscreteSet(Complement(s.set, Canonical(universe)).intervals...)
+}
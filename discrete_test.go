@@ -0,0 +1,96 @@
+package interval
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestCanonical(t *testing.T) {
+	var canonicalCases = []struct {
+		i Interval[int8]
+		w Interval[int8]
+	}{
+		{ // 0
+			i: Interval[int8]{Begin: 1, IncBegin: true, End: 2, IncEnd: true},
+			w: Interval[int8]{Begin: 1, IncBegin: true, End: 3},
+		},
+		{ // 1
+			i: Interval[int8]{Begin: 0, End: 3},
+			w: Interval[int8]{Begin: 1, IncBegin: true, End: 3},
+		},
+		{ // 2
+			i: Interval[int8]{Begin: 1, End: 2},
+			w: Interval[int8]{},
+		},
+		{ // 3
+			i: Interval[int8]{Begin: 100, End: math.MaxInt8, IncEnd: true},
+			w: Interval[int8]{Begin: 101, IncBegin: true, End: math.MaxInt8, IncEnd: true},
+		},
+		{ // 4
+			i: Interval[int8]{Begin: math.MaxInt8, UnboundedEnd: true},
+			w: Interval[int8]{},
+		},
+		{ // 5
+			i: Interval[int8]{UnboundedBegin: true, End: math.MinInt8},
+			w: Interval[int8]{},
+		},
+		{ // 6
+			i: Interval[int8]{Begin: 5, IncBegin: true, UnboundedBegin: true, End: -1, IncEnd: true},
+			w: Interval[int8]{UnboundedBegin: true, End: 0},
+		},
+	}
+	for n, tc := range canonicalCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			if c := Canonical(tc.i); c != tc.w {
+				t.Errorf("want Canonical(%s) = %s but get %s", tc.i, tc.w, c)
+			}
+		})
+	}
+}
+
+func TestDiscreteSet(t *testing.T) {
+	var s DiscreteSet[int]
+	s.Add(Interval[int]{Begin: 1, IncBegin: true, End: 2, IncEnd: true})
+	if !s.Add(Interval[int]{Begin: 3, IncBegin: true, End: 4, IncEnd: true}) {
+		t.Errorf("want Add([3, 4]) changed %s", s)
+	}
+	if w := "{[1, 5)}"; s.String() != w {
+		t.Errorf("want %s but get %s", w, s)
+	}
+	if s.Add(Interval[int]{Begin: 0, End: 3}) {
+		t.Errorf("want Add((0, 3)) not changed %s", s)
+	}
+	if !s.Contains(Interval[int]{Begin: 0, End: 4, IncEnd: true}) || !s.ContainsPoint(4) || s.ContainsPoint(5) {
+		t.Errorf("want %s contains (0, 4] and 4 but not 5", s)
+	}
+
+	x := NewDiscreteSet(
+		Interval[int]{Begin: 2, IncBegin: true, End: 3, IncEnd: true},
+		Interval[int]{Begin: 5, IncBegin: true, End: 6, IncEnd: true},
+	)
+	if d, w := s.Difference(x), NewDiscreteSet(
+		Interval[int]{Begin: 1, IncBegin: true, End: 1, IncEnd: true},
+		Interval[int]{Begin: 4, IncBegin: true, End: 6, IncEnd: true},
+	); !d.Equal(w) {
+		t.Errorf("want %s.Difference(%s) = %s but get %s", s, x, w, d)
+	}
+	if u, w := s.Union(x), NewDiscreteSet(Interval[int]{Begin: 1, IncBegin: true, End: 7}); !u.Equal(w) {
+		t.Errorf("want %s.Union(%s) = %s but get %s", s, x, w, u)
+	}
+	if i, w := s.Intersect(x), NewDiscreteSet(Interval[int]{Begin: 2, IncBegin: true, End: 4}); !i.Equal(w) {
+		t.Errorf("want %s.Intersect(%s) = %s but get %s", s, x, w, i)
+	}
+	if d, w := s.Subtract(x), NewDiscreteSet(
+		Interval[int]{Begin: 1, IncBegin: true, End: 2},
+		Interval[int]{Begin: 4, IncBegin: true, End: 5},
+	); !d.Equal(w) {
+		t.Errorf("want %s.Subtract(%s) = %s but get %s", s, x, w, d)
+	}
+
+	m := NewDiscreteSet(Interval[int8]{Begin: 100, IncBegin: true, End: math.MaxInt8, IncEnd: true})
+	if c, w := m.Complement(Interval[int8]{UnboundedBegin: true, UnboundedEnd: true}),
+		NewDiscreteSet(Interval[int8]{UnboundedBegin: true, End: 100}); !c.Equal(w) {
+		t.Errorf("want %s.Complement((-inf, +inf)) = %s but get %s", m, w, c)
+	}
+}
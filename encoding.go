@@ -0,0 +1,306 @@
+package interval
+
+import (
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// MarshalText implements encoding.TextMarshaler, the interval is encoded in
+// the form printed by String.
+func (i Interval[T]) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, it accepts the form
+// printed by String.
+func (i *Interval[T]) UnmarshalText(text []byte) error {
+	x, err := ParseInterval[T](string(text))
+	if err != nil {
+		return err
+	}
+	*i = x
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, the ordered set is encoded
+// in the form printed by String.
+func (s OrderedSet[T]) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, it accepts the form
+// printed by String.
+func (s *OrderedSet[T]) UnmarshalText(text []byte) error {
+	x, err := ParseOrderedSet[T](string(text))
+	if err != nil {
+		return err
+	}
+	*s = x
+	return nil
+}
+
+// binaryVersion is the version of the binary layout written by
+// MarshalBinary.
+//
+// The layout of version 1 is:
+//
+//	version   byte
+//	count     uvarint, the number of intervals
+//	flags     (count+1)/2 bytes, 4 bits per interval, low nibble first:
+//	          IncBegin, IncEnd, UnboundedBegin, UnboundedEnd
+//	endpoints begin and end of every interval in order, unbounded
+//	          endpoints are omitted
+//
+// Integer endpoints are written as a varint (signed) or uvarint (unsigned)
+// for the first endpoint and as uvarint deltas from the previous endpoint
+// after that. Float endpoints are written as 8 bytes little endian IEEE 754
+// bits and string endpoints as an uvarint length followed by the bytes.
+const binaryVersion = 1
+
+const (
+	flagIncBegin = 1 << iota
+	flagIncEnd
+	flagUnboundedBegin
+	flagUnboundedEnd
+)
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (i Interval[T]) MarshalBinary() ([]byte, error) {
+	if i.IsEmpty() {
+		return marshalIntervals[T](nil), nil
+	}
+	return marshalIntervals([]Interval[T]{i}), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Interval[T]) UnmarshalBinary(data []byte) error {
+	intervals, err := unmarshalIntervals[T](data)
+	if err != nil {
+		return err
+	}
+	switch len(intervals) {
+	case 0:
+		*i = Interval[T]{}
+	case 1:
+		*i = intervals[0]
+	default:
+		return fmt.Errorf("interval: unmarshal binary: %d intervals for Interval", len(intervals))
+	}
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s OrderedSet[T]) MarshalBinary() ([]byte, error) {
+	return marshalIntervals(s.intervals), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// Overlapping or unordered intervals are merged.
+func (s *OrderedSet[T]) UnmarshalBinary(data []byte) error {
+	intervals, err := unmarshalIntervals[T](data)
+	if err != nil {
+		return err
+	}
+	*s = FromIntervals(intervals)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the binary layout.
+func (i Interval[T]) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the binary layout.
+func (i *Interval[T]) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// GobEncode implements gob.GobEncoder using the binary layout.
+func (s OrderedSet[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the binary layout.
+func (s *OrderedSet[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+func marshalIntervals[T cmp.Ordered](intervals []Interval[T]) []byte {
+	buf := []byte{binaryVersion}
+	buf = binary.AppendUvarint(buf, uint64(len(intervals)))
+	flags := make([]byte, (len(intervals)+1)/2)
+	for n, i := range intervals {
+		var f byte
+		if i.IncBegin && !i.UnboundedBegin {
+			f |= flagIncBegin
+		}
+		if i.IncEnd && !i.UnboundedEnd {
+			f |= flagIncEnd
+		}
+		if i.UnboundedBegin {
+			f |= flagUnboundedBegin
+		}
+		if i.UnboundedEnd {
+			f |= flagUnboundedEnd
+		}
+		flags[n/2] |= f << (4 * (n % 2))
+	}
+	buf = append(buf, flags...)
+
+	e := binaryEncoder{buf: buf}
+	for _, i := range intervals {
+		if !i.UnboundedBegin {
+			e.value(reflect.ValueOf(i.Begin))
+		}
+		if !i.UnboundedEnd {
+			e.value(reflect.ValueOf(i.End))
+		}
+	}
+	return e.buf
+}
+
+func unmarshalIntervals[T cmp.Ordered](data []byte) ([]Interval[T], error) {
+	if len(data) == 0 {
+		return nil, errors.New("interval: unmarshal binary: no data")
+	}
+	if data[0] != binaryVersion {
+		return nil, fmt.Errorf("interval: unmarshal binary: unsupported version %d", data[0])
+	}
+	data = data[1:]
+	count, n := binary.Uvarint(data)
+	if n <= 0 {
+		return nil, errors.New("interval: unmarshal binary: invalid count")
+	}
+	data = data[n:]
+	if count > uint64(len(data))*2 {
+		return nil, errors.New("interval: unmarshal binary: truncated flags")
+	}
+	flags := data[:(count+1)/2]
+
+	d := binaryDecoder{data: data[len(flags):]}
+	intervals := make([]Interval[T], count)
+	for n := range intervals {
+		i := &intervals[n]
+		f := flags[n/2] >> (4 * (n % 2))
+		i.IncBegin = f&flagIncBegin != 0
+		i.IncEnd = f&flagIncEnd != 0
+		i.UnboundedBegin = f&flagUnboundedBegin != 0
+		i.UnboundedEnd = f&flagUnboundedEnd != 0
+		if !i.UnboundedBegin {
+			if err := d.value(reflect.ValueOf(&i.Begin).Elem()); err != nil {
+				return nil, err
+			}
+		}
+		if !i.UnboundedEnd {
+			if err := d.value(reflect.ValueOf(&i.End).Elem()); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(d.data) != 0 {
+		return nil, fmt.Errorf("interval: unmarshal binary: %d trailing bytes", len(d.data))
+	}
+	return intervals, nil
+}
+
+type binaryEncoder struct {
+	buf   []byte
+	prev  uint64
+	delta bool
+}
+
+func (e *binaryEncoder) value(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		x := uint64(v.Int())
+		if e.delta {
+			e.buf = binary.AppendUvarint(e.buf, x-e.prev)
+		} else {
+			e.buf = binary.AppendVarint(e.buf, v.Int())
+		}
+		e.prev, e.delta = x, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		x := v.Uint()
+		e.buf = binary.AppendUvarint(e.buf, x-e.prev)
+		e.prev = x
+	case reflect.Float32, reflect.Float64:
+		e.buf = binary.LittleEndian.AppendUint64(e.buf, math.Float64bits(v.Float()))
+	case reflect.String:
+		e.buf = binary.AppendUvarint(e.buf, uint64(v.Len()))
+		e.buf = append(e.buf, v.String()...)
+	}
+}
+
+type binaryDecoder struct {
+	data  []byte
+	prev  uint64
+	delta bool
+}
+
+var errTruncated = errors.New("interval: unmarshal binary: truncated endpoints")
+
+func (d *binaryDecoder) uvarint() (uint64, error) {
+	x, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		return 0, errTruncated
+	}
+	d.data = d.data[n:]
+	return x, nil
+}
+
+func (d *binaryDecoder) value(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var x int64
+		if d.delta {
+			delta, err := d.uvarint()
+			if err != nil {
+				return err
+			}
+			x = int64(d.prev + delta)
+		} else {
+			var n int
+			if x, n = binary.Varint(d.data); n <= 0 {
+				return errTruncated
+			}
+			d.data = d.data[n:]
+		}
+		if v.OverflowInt(x) {
+			return fmt.Errorf("interval: unmarshal binary: endpoint %d overflows %s", x, v.Type())
+		}
+		v.SetInt(x)
+		d.prev, d.delta = uint64(x), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		delta, err := d.uvarint()
+		if err != nil {
+			return err
+		}
+		x := d.prev + delta
+		if v.OverflowUint(x) {
+			return fmt.Errorf("interval: unmarshal binary: endpoint %d overflows %s", x, v.Type())
+		}
+		v.SetUint(x)
+		d.prev = x
+	case reflect.Float32, reflect.Float64:
+		if len(d.data) < 8 {
+			return errTruncated
+		}
+		v.SetFloat(math.Float64frombits(binary.LittleEndian.Uint64(d.data)))
+		d.data = d.data[8:]
+	case reflect.String:
+		n, err := d.uvarint()
+		if err != nil {
+			return err
+		}
+		if n > uint64(len(d.data)) {
+			return errTruncated
+		}
+		v.SetString(string(d.data[:n]))
+		d.data = d.data[n:]
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package interval
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestOrderedSet_Binary(t *testing.T) {
+	var binaryCases = []string{
+		"",
+		"===* ===",
+		"<= =>",
+		"<>",
+		"  *=e==  p  *==*  ==>",
+	}
+	for n, tc := range binaryCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			s := parseOrderedSet(tc)
+			data, err := s.MarshalBinary()
+			if err != nil {
+				t.Fatalf("want %s.MarshalBinary() succeeds but get %v", s, err)
+			}
+			var r OrderedSet[int]
+			if err := r.UnmarshalBinary(data); err != nil || !r.Equal(s) {
+				t.Errorf("want UnmarshalBinary(%x) = %s but get %s, %v", data, s, r, err)
+			}
+			for i := 0; i < len(data); i++ {
+				if err := r.UnmarshalBinary(data[:i]); err == nil {
+					t.Errorf("want UnmarshalBinary(%x) fails but get %s", data[:i], r)
+				}
+			}
+		})
+	}
+}
+
+func TestOrderedSet_BinaryOrdered(t *testing.T) {
+	var i64 OrderedSet[int64]
+	i64.Add(Interval[int64]{Begin: math.MinInt64, IncBegin: true, End: -1})
+	i64.Add(Interval[int64]{Begin: 1, End: math.MaxInt64, IncEnd: true})
+	data, _ := i64.MarshalBinary()
+	var ri64 OrderedSet[int64]
+	if err := ri64.UnmarshalBinary(data); err != nil || !ri64.Equal(i64) {
+		t.Errorf("want UnmarshalBinary(%x) = %s but get %s, %v", data, i64, ri64, err)
+	}
+
+	var u8 OrderedSet[uint8]
+	u8.Add(Interval[uint8]{Begin: 3, IncBegin: true, End: 255, IncEnd: true})
+	data, _ = u8.MarshalBinary()
+	if w := []byte{binaryVersion, 1, flagIncBegin | flagIncEnd, 3, 0xfc, 0x01}; !bytes.Equal(data, w) {
+		t.Errorf("want %s.MarshalBinary() = %x but get %x", u8, w, data)
+	}
+	var ru8 OrderedSet[uint8]
+	if err := ru8.UnmarshalBinary(data); err != nil || !ru8.Equal(u8) {
+		t.Errorf("want UnmarshalBinary(%x) = %s but get %s, %v", data, u8, ru8, err)
+	}
+	data[4] = 0xfd
+	if err := ru8.UnmarshalBinary(data); err == nil {
+		t.Errorf("want UnmarshalBinary(%x) fails but get %s", data, ru8)
+	}
+
+	var f OrderedSet[float64]
+	f.Add(Interval[float64]{Begin: -1.5, IncBegin: true, End: 0.25})
+	data, _ = f.MarshalBinary()
+	var rf OrderedSet[float64]
+	if err := rf.UnmarshalBinary(data); err != nil || !rf.Equal(f) {
+		t.Errorf("want UnmarshalBinary(%x) = %s but get %s, %v", data, f, rf, err)
+	}
+
+	var s OrderedSet[string]
+	s.Add(Interval[string]{Begin: "a", IncBegin: true, End: "c"})
+	s.Add(Interval[string]{Begin: "x", UnboundedEnd: true})
+	data, _ = s.MarshalBinary()
+	var rs OrderedSet[string]
+	if err := rs.UnmarshalBinary(data); err != nil || !rs.Equal(s) {
+		t.Errorf("want UnmarshalBinary(%x) = %s but get %s, %v", data, s, rs, err)
+	}
+}
+
+func TestOrderedSet_Gob(t *testing.T) {
+	type record struct {
+		I Interval[int]
+		S OrderedSet[int]
+	}
+	w := record{I: parseInterval("--*===>"), S: parseOrderedSet("===* ===")}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(w); err != nil {
+		t.Fatalf("want gob encode succeeds but get %v", err)
+	}
+	var r record
+	if err := gob.NewDecoder(&buf).Decode(&r); err != nil {
+		t.Fatalf("want gob decode succeeds but get %v", err)
+	}
+	if !r.I.Equal(w.I) || !r.S.Equal(w.S) {
+		t.Errorf("want gob decode = %s, %s but get %s, %s", w.I, w.S, r.I, r.S)
+	}
+}
+
+func TestOrderedSet_Text(t *testing.T) {
+	s := parseOrderedSet("<= *==*")
+	text, err := s.MarshalText()
+	if err != nil {
+		t.Fatalf("want %s.MarshalText() succeeds but get %v", s, err)
+	}
+	var r OrderedSet[int]
+	if err := r.UnmarshalText(text); err != nil || !r.Equal(s) {
+		t.Errorf("want UnmarshalText(%s) = %s but get %s, %v", text, s, r, err)
+	}
+
+	i := parseInterval("--*===")
+	text, _ = i.MarshalText()
+	var ri Interval[int]
+	if err := ri.UnmarshalText(text); err != nil || !ri.Equal(i) {
+		t.Errorf("want UnmarshalText(%s) = %s but get %s, %v", text, i, ri, err)
+	}
+}
@@ -1,3 +1,3 @@
 module github.com/go-camp/interval
 
-go 1.15
+go 1.23
@@ -0,0 +1,579 @@
+package interval
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// HyperBox is an N-dimensional box of the points whose n-th coordinate is
+// in the n-th interval. Boxes of different dimensions have no common point.
+type HyperBox[T cmp.Ordered] []Interval[T]
+
+func (b HyperBox[T]) String() string {
+	axes := make([]string, len(b))
+	for n, i := range b {
+		axes[n] = i.String()
+	}
+	return strings.Join(axes, " x ")
+}
+
+// Dim returns the number of dimensions of receiver box.
+func (b HyperBox[T]) Dim() int {
+	return len(b)
+}
+
+// IsEmpty returns true if receiver box has no dimension or no point.
+func (b HyperBox[T]) IsEmpty() bool {
+	if len(b) == 0 {
+		return true
+	}
+	for _, i := range b {
+		if i.IsEmpty() {
+			return true
+		}
+	}
+	return false
+}
+
+// Equal returns true if receiver box is equals x box.
+func (b HyperBox[T]) Equal(x HyperBox[T]) bool {
+	if b.IsEmpty() || x.IsEmpty() {
+		return b.IsEmpty() && x.IsEmpty()
+	}
+	if len(b) != len(x) {
+		return false
+	}
+	for n := range b {
+		if !b[n].Equal(x[n]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Contains returns true if x box is completely covered by receiver box.
+func (b HyperBox[T]) Contains(x HyperBox[T]) bool {
+	if x.IsEmpty() {
+		return true
+	}
+	if len(b) != len(x) {
+		return false
+	}
+	for n := range b {
+		if !b[n].Contains(x[n]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Intersect returns the intersection of receiver box with x box, it is nil
+// if they have no common point.
+func (b HyperBox[T]) Intersect(x HyperBox[T]) HyperBox[T] {
+	if len(b) != len(x) {
+		return nil
+	}
+	in := make(HyperBox[T], len(b))
+	for n := range b {
+		if in[n] = b[n].Intersect(x[n]); in[n].IsEmpty() {
+			return nil
+		}
+	}
+	return in
+}
+
+// Overlaps returns true if receiver box and x box have a common point.
+func (b HyperBox[T]) Overlaps(x HyperBox[T]) bool {
+	if len(b) != len(x) || len(b) == 0 {
+		return false
+	}
+	for n := range b {
+		if b[n].Intersect(x[n]).IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+// Encompass returns the smallest box that covers both receiver box and x
+// box, x box is returned if receiver box is empty.
+func (b HyperBox[T]) Encompass(x HyperBox[T]) HyperBox[T] {
+	if x.IsEmpty() {
+		return slices.Clone(b)
+	}
+	if b.IsEmpty() || len(b) != len(x) {
+		return slices.Clone(x)
+	}
+	e := make(HyperBox[T], len(b))
+	for n := range b {
+		e[n] = b[n].Encompass(x[n])
+	}
+	return e
+}
+
+// hyperSlab is the points of a hyper box set with the first coordinate in
+// an interval, their other coordinates are the points of rest.
+type hyperSlab[T cmp.Ordered] struct {
+	x    Interval[T]
+	rest HyperBoxSet[T]
+}
+
+// HyperBoxSet is a set of points in N dimensions. Like BoxSet it is kept as
+// ordered and non-overlapping slabs along the first axis, each with a
+// hyper box set of the other axes, and adjacent slabs with equal hyper box
+// sets are merged, so every set of points has a single canonical
+// decomposition into boxes.
+//
+// The zero value is an empty set which takes the dimension of the first box
+// added. Mixing dimensions is a programming error: Add, Remove, Union,
+// Intersect, Subtract and Difference panic if a non-empty set meets a
+// non-empty box or set of another dimension. The queries Contains,
+// ContainsPoint and Overlaps return false for another dimension, since
+// boxes of different dimensions have no common point.
+type HyperBoxSet[T cmp.Ordered] struct {
+	dim int
+	// full tells whether a set of zero dimension has its single point, it
+	// is only used for the rest of the slabs of one dimensional sets.
+	full  bool
+	slabs []hyperSlab[T]
+}
+
+// NewHyperBoxSet returns a hyper box set containing all of boxes.
+func NewHyperBoxSet[T cmp.Ordered](boxes ...HyperBox[T]) HyperBoxSet[T] {
+	var s HyperBoxSet[T]
+	for _, b := range boxes {
+		s.Add(b)
+	}
+	return s
+}
+
+// fullHyperBoxSet returns the hyper box set containing every point of b, a
+// box of zero dimension is the single point of zero dimension.
+func fullHyperBoxSet[T cmp.Ordered](b HyperBox[T]) HyperBoxSet[T] {
+	if len(b) == 0 {
+		return HyperBoxSet[T]{full: true}
+	}
+	return HyperBoxSet[T]{
+		dim:   len(b),
+		slabs: []hyperSlab[T]{{b[0], fullHyperBoxSet(b[1:])}},
+	}
+}
+
+// Copy returns a copy of a hyper box set that without affecting the original.
+func (s HyperBoxSet[T]) Copy() HyperBoxSet[T] {
+	c := HyperBoxSet[T]{dim: s.dim, full: s.full}
+	if len(s.slabs) > 0 {
+		c.slabs = make([]hyperSlab[T], len(s.slabs))
+		for n, sl := range s.slabs {
+			c.slabs[n] = hyperSlab[T]{sl.x, sl.rest.Copy()}
+		}
+	}
+	return c
+}
+
+// Dim returns the number of dimensions of this hyper box set, it is 0 for
+// a set that has never had a box.
+func (s HyperBoxSet[T]) Dim() int {
+	return s.dim
+}
+
+// IsEmpty returns true if no points in this hyper box set.
+func (s HyperBoxSet[T]) IsEmpty() bool {
+	if s.dim == 0 {
+		return !s.full
+	}
+	return len(s.slabs) == 0
+}
+
+func (s HyperBoxSet[T]) Equal(x HyperBoxSet[T]) bool {
+	if s.IsEmpty() || x.IsEmpty() {
+		return s.IsEmpty() && x.IsEmpty()
+	}
+	if s.dim != x.dim || len(s.slabs) != len(x.slabs) {
+		return false
+	}
+	for n := range s.slabs {
+		if !s.slabs[n].x.Equal(x.slabs[n].x) || !s.slabs[n].rest.Equal(x.slabs[n].rest) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s HyperBoxSet[T]) String() string {
+	var b strings.Builder
+	b.WriteByte('{')
+	for n, x := range s.Boxes() {
+		if n > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(x.String())
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// Boxes returns the canonical decomposition of this hyper box set into
+// non-overlapping boxes, ordered by the first axis and then by the others.
+func (s HyperBoxSet[T]) Boxes() []HyperBox[T] {
+	if s.dim == 0 {
+		if s.full {
+			return []HyperBox[T]{{}}
+		}
+		return nil
+	}
+	var boxes []HyperBox[T]
+	for _, sl := range s.slabs {
+		for _, rest := range sl.rest.Boxes() {
+			boxes = append(boxes, append(HyperBox[T]{sl.x}, rest...))
+		}
+	}
+	return boxes
+}
+
+// Bound returns the smallest box that covers this hyper box set.
+func (s HyperBoxSet[T]) Bound() HyperBox[T] {
+	var bound HyperBox[T]
+	for _, b := range s.Boxes() {
+		bound = bound.Encompass(b)
+	}
+	return bound
+}
+
+// searchLow returns the first index in s.slabs that is not before x.
+func (s *HyperBoxSet[T]) searchLow(x Interval[T]) int {
+	return sort.Search(len(s.slabs), func(i int) bool {
+		return !s.slabs[i].x.LtBeginOf(x)
+	})
+}
+
+// searchHigh returns the index of the first slab in s.slabs that is
+// entirely after x.
+func (s *HyperBoxSet[T]) searchHigh(x Interval[T]) int {
+	return sort.Search(len(s.slabs), func(i int) bool {
+		return x.LtBeginOf(s.slabs[i].x)
+	})
+}
+
+// Contains returns true if x box is completely covered by this hyper box
+// set, an empty box is covered by any hyper box set.
+func (s HyperBoxSet[T]) Contains(x HyperBox[T]) bool {
+	if x.IsEmpty() {
+		return true
+	}
+	if len(x) != s.dim {
+		return false
+	}
+	return s.contains(x)
+}
+
+func (s HyperBoxSet[T]) contains(x HyperBox[T]) bool {
+	if s.dim == 0 {
+		return s.full
+	}
+	rest := x[0]
+	for _, sl := range s.slabs[s.searchLow(x[0]):s.searchHigh(x[0])] {
+		gap, after := rest.Bisect(sl.x)
+		if !gap.IsEmpty() || !sl.rest.contains(x[1:]) {
+			return false
+		}
+		rest = after
+	}
+	return rest.IsEmpty()
+}
+
+// ContainsPoint returns true if the point of coordinates p is in this hyper
+// box set.
+func (s HyperBoxSet[T]) ContainsPoint(p ...T) bool {
+	b := make(HyperBox[T], len(p))
+	for n, v := range p {
+		b[n] = point(v)
+	}
+	return s.Contains(b)
+}
+
+// Overlaps returns true if this hyper box set has a point in x box.
+func (s HyperBoxSet[T]) Overlaps(x HyperBox[T]) bool {
+	if x.IsEmpty() || len(x) != s.dim {
+		return false
+	}
+	return s.overlaps(x)
+}
+
+func (s HyperBoxSet[T]) overlaps(x HyperBox[T]) bool {
+	if s.dim == 0 {
+		return s.full
+	}
+	for _, sl := range s.slabs[s.searchLow(x[0]):s.searchHigh(x[0])] {
+		if sl.rest.overlaps(x[1:]) {
+			return true
+		}
+	}
+	return false
+}
+
+func appendHyperSlab[T cmp.Ordered](slabs []hyperSlab[T], sl hyperSlab[T]) []hyperSlab[T] {
+	if n := len(slabs) - 1; n >= 0 && slabs[n].rest.Equal(sl.rest) {
+		if ad := slabs[n].x.Adjoin(sl.x); !ad.IsEmpty() {
+			slabs[n].x = ad
+			return slabs
+		}
+	}
+	return append(slabs, sl)
+}
+
+// update replaces the rest of the portion of this hyper box set covered by
+// x interval with the results of f, f is called with an empty set for the
+// gaps between slabs and must not modify its argument.
+// update returns true if this hyper box set changed.
+func (s *HyperBoxSet[T]) update(x Interval[T], f func(rest HyperBoxSet[T]) HyperBoxSet[T]) bool {
+	if x.IsEmpty() {
+		return false
+	}
+
+	low, high := s.searchLow(x), s.searchHigh(x)
+	slabs := make([]hyperSlab[T], 0, len(s.slabs)+high-low+2)
+	slabs = append(slabs, s.slabs[:low]...)
+	push := func(i Interval[T], rest HyperBoxSet[T]) {
+		if !i.IsEmpty() && !rest.IsEmpty() {
+			slabs = appendHyperSlab(slabs, hyperSlab[T]{i, rest})
+		}
+	}
+	changed := false
+	apply := func(i Interval[T], rest HyperBoxSet[T]) {
+		if i.IsEmpty() {
+			return
+		}
+		fr := f(rest)
+		if !fr.Equal(rest) {
+			changed = true
+		}
+		push(i, fr)
+	}
+	gap := HyperBoxSet[T]{dim: s.dim - 1}
+	rest := x
+	for _, sl := range s.slabs[low:high] {
+		left, right := sl.x.Bisect(x)
+		before, after := rest.Bisect(sl.x)
+		push(left, sl.rest)
+		apply(before, gap)
+		apply(sl.x.Intersect(x), sl.rest)
+		push(right, sl.rest)
+		rest = after
+	}
+	apply(rest, gap)
+	if high < len(s.slabs) {
+		push(s.slabs[high].x, s.slabs[high].rest)
+		slabs = append(slabs, s.slabs[high+1:]...)
+	}
+	s.slabs = slabs
+	return changed
+}
+
+// Add adds x box to this hyper box set.
+// Add returns true if this hyper box set changed.
+func (s *HyperBoxSet[T]) Add(x HyperBox[T]) bool {
+	if x.IsEmpty() {
+		return false
+	}
+	if !s.IsEmpty() {
+		mustSameDim(s.dim, len(x))
+	}
+	s.dim, s.full = len(x), false
+	rest := fullHyperBoxSet(x[1:])
+	return s.update(x[0], func(r HyperBoxSet[T]) HyperBoxSet[T] {
+		return r.Union(rest)
+	})
+}
+
+// Remove removes x box from this hyper box set.
+// Remove returns true if this hyper box set changed.
+func (s *HyperBoxSet[T]) Remove(x HyperBox[T]) bool {
+	if x.IsEmpty() || s.IsEmpty() {
+		return false
+	}
+	mustSameDim(s.dim, len(x))
+	rest := fullHyperBoxSet(x[1:])
+	return s.update(x[0], func(r HyperBoxSet[T]) HyperBoxSet[T] {
+		return r.Subtract(rest)
+	})
+}
+
+// mustSameDim panics if a set of dim dimensions is mixed with a box or a set
+// of x dimensions.
+func mustSameDim(dim, x int) {
+	if dim != x {
+		panic("interval: mixed hyper boxes of " + strconv.Itoa(dim) + " and " + strconv.Itoa(x) + " dimensions")
+	}
+}
+
+// Union returns a hyper box set containing all points in s or x.
+func (s HyperBoxSet[T]) Union(x HyperBoxSet[T]) HyperBoxSet[T] {
+	switch {
+	case x.IsEmpty():
+		return s.Copy()
+	case s.IsEmpty():
+		return x.Copy()
+	}
+	mustSameDim(s.dim, x.dim)
+	u := s.Copy()
+	for _, sl := range x.slabs {
+		u.update(sl.x, func(r HyperBoxSet[T]) HyperBoxSet[T] {
+			return r.Union(sl.rest)
+		})
+	}
+	return u
+}
+
+// Intersect returns a hyper box set containing all points of s that also
+// belong to x.
+func (s HyperBoxSet[T]) Intersect(x HyperBoxSet[T]) HyperBoxSet[T] {
+	if s.IsEmpty() || x.IsEmpty() {
+		return HyperBoxSet[T]{}
+	}
+	mustSameDim(s.dim, x.dim)
+	if s.dim == 0 {
+		return HyperBoxSet[T]{full: true}
+	}
+	in := HyperBoxSet[T]{dim: s.dim}
+	for i, j := 0, 0; i < len(s.slabs) && j < len(x.slabs); {
+		a, b := s.slabs[i], x.slabs[j]
+		if xi := a.x.Intersect(b.x); !xi.IsEmpty() {
+			if rest := a.rest.Intersect(b.rest); !rest.IsEmpty() {
+				in.slabs = appendHyperSlab(in.slabs, hyperSlab[T]{xi, rest})
+			}
+		}
+		if compareEnd(a.x, b.x) <= 0 {
+			i++
+		} else {
+			j++
+		}
+	}
+	return in
+}
+
+// Subtract returns a hyper box set containing all points in s but not in x.
+func (s HyperBoxSet[T]) Subtract(x HyperBoxSet[T]) HyperBoxSet[T] {
+	if s.IsEmpty() || x.IsEmpty() {
+		return s.Copy()
+	}
+	mustSameDim(s.dim, x.dim)
+	if s.dim == 0 {
+		return HyperBoxSet[T]{}
+	}
+	d := s.Copy()
+	for _, sl := range x.slabs {
+		d.update(sl.x, func(r HyperBoxSet[T]) HyperBoxSet[T] {
+			return r.Subtract(sl.rest)
+		})
+	}
+	return d
+}
+
+// Difference returns a hyper box set containing all points in either of s
+// and x, but not in their intersection.
+func (s HyperBoxSet[T]) Difference(x HyperBoxSet[T]) HyperBoxSet[T] {
+	return s.Subtract(x).Union(x.Subtract(s))
+}
+
+// Volume returns the total volume of the boxes in s, it is +Inf if s has an
+// unbounded box of non-zero width on the other axes.
+func Volume[T Number](s HyperBoxSet[T]) float64 {
+	if s.dim == 0 {
+		if s.full {
+			return 1
+		}
+		return 0
+	}
+	var volume float64
+	for _, sl := range s.slabs {
+		volume += product(width(sl.x), Volume(sl.rest))
+	}
+	return volume
+}
+
+// HyperBoxIndex is a static index of hyper boxes answering which boxes
+// intersect a query box. It is a k-d tree split at the median begin of the
+// boxes on each axis in turn, and every node keeps the bound of its subtree
+// to prune the search.
+type HyperBoxIndex[T cmp.Ordered] struct {
+	root *kdNode[T]
+	len  int
+}
+
+type kdNode[T cmp.Ordered] struct {
+	index       int
+	box, bound  HyperBox[T]
+	left, right *kdNode[T]
+}
+
+// NewHyperBoxIndex returns an index of boxes in O(n log² n). Empty boxes are
+// left out, and like HyperBoxSet it panics if the non-empty boxes have
+// different dimensions. The boxes are copied, so modifying them afterwards
+// does not affect the index.
+func NewHyperBoxIndex[T cmp.Ordered](boxes ...HyperBox[T]) HyperBoxIndex[T] {
+	var nodes []kdNode[T]
+	for n, b := range boxes {
+		if b.IsEmpty() {
+			continue
+		}
+		if len(nodes) > 0 {
+			mustSameDim(len(nodes[0].box), len(b))
+		}
+		nodes = append(nodes, kdNode[T]{index: n, box: slices.Clone(b)})
+	}
+	return HyperBoxIndex[T]{root: buildKD(nodes, 0), len: len(nodes)}
+}
+
+func buildKD[T cmp.Ordered](nodes []kdNode[T], depth int) *kdNode[T] {
+	if len(nodes) == 0 {
+		return nil
+	}
+	axis := depth % len(nodes[0].box)
+	slices.SortFunc(nodes, func(a, b kdNode[T]) int {
+		return compareBegin(a.box[axis], b.box[axis])
+	})
+	m := len(nodes) / 2
+	n := &nodes[m]
+	n.left = buildKD(nodes[:m], depth+1)
+	n.right = buildKD(nodes[m+1:], depth+1)
+	n.bound = n.box
+	if n.left != nil {
+		n.bound = n.bound.Encompass(n.left.bound)
+	}
+	if n.right != nil {
+		n.bound = n.bound.Encompass(n.right.bound)
+	}
+	return n
+}
+
+// Len returns the number of boxes in this index.
+func (idx HyperBoxIndex[T]) Len() int {
+	return idx.len
+}
+
+// Search returns an iterator over the boxes that overlap q box, with their
+// indexes in the boxes passed to NewHyperBoxIndex. The yielded boxes are
+// shared with the index and must not be modified.
+func (idx HyperBoxIndex[T]) Search(q HyperBox[T]) iter.Seq2[int, HyperBox[T]] {
+	return func(yield func(int, HyperBox[T]) bool) {
+		idx.root.search(q, yield)
+	}
+}
+
+func (n *kdNode[T]) search(q HyperBox[T], yield func(int, HyperBox[T]) bool) bool {
+	if n == nil || !n.bound.Overlaps(q) {
+		return true
+	}
+	if !n.left.search(q, yield) {
+		return false
+	}
+	if n.box.Overlaps(q) && !yield(n.index, n.box) {
+		return false
+	}
+	return n.right.search(q, yield)
+}
@@ -0,0 +1,257 @@
+package interval
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestHyperBox(t *testing.T) {
+	a := HyperBox[int]{closedOpen(0, 4), closedOpen(0, 2), closedOpen(0, 1)}
+	b := HyperBox[int]{closedOpen(2, 6), closedOpen(1, 3), closedOpen(0, 2)}
+	if w, i := (HyperBox[int]{closedOpen(2, 4), closedOpen(1, 2), closedOpen(0, 1)}), a.Intersect(b); !i.Equal(w) {
+		t.Errorf("want %s.Intersect(%s) = %s but get %s", a, b, w, i)
+	}
+	if !a.Overlaps(b) || a.Overlaps(a[:2]) {
+		t.Errorf("want %s overlaps only boxes of the same dimension", a)
+	}
+	c := HyperBox[int]{closedOpen(0, 4), closedOpen(2, 3), closedOpen(0, 1)}
+	if a.Overlaps(c) || !a.Intersect(c).IsEmpty() {
+		t.Errorf("want %s not overlaps %s", a, c)
+	}
+	if w, e := (HyperBox[int]{closedOpen(0, 6), closedOpen(0, 3), closedOpen(0, 2)}), a.Encompass(b); !e.Equal(w) {
+		t.Errorf("want %s.Encompass(%s) = %s but get %s", a, b, w, e)
+	}
+	if w := "[0, 4) x [0, 2) x [0, 1)"; a.String() != w {
+		t.Errorf("want %s but get %s", w, a)
+	}
+}
+
+func TestHyperBoxSet(t *testing.T) {
+	a := HyperBox[int]{closedOpen(0, 4), closedOpen(0, 2), closedOpen(0, 1)}
+	b := HyperBox[int]{closedOpen(2, 6), closedOpen(0, 2), closedOpen(0, 1)}
+
+	var s HyperBoxSet[int]
+	if !s.Add(a) || !s.Add(b) {
+		t.Fatalf("want Add changed %s", s)
+	}
+	if s.Add(HyperBox[int]{closedOpen(1, 5), closedOpen(0, 1), closedOpen(0, 1)}) {
+		t.Errorf("want Add of a covered box not changed %s", s)
+	}
+	if w := "{[0, 6) x [0, 2) x [0, 1)}"; s.String() != w {
+		t.Errorf("want %s but get %s", w, s)
+	}
+	if s.Dim() != 3 {
+		t.Errorf("want dimension 3 but get %d", s.Dim())
+	}
+	if !s.ContainsPoint(5, 1, 0) || s.ContainsPoint(6, 1, 0) {
+		t.Errorf("want %s contains (5, 1, 0) but not (6, 1, 0)", s)
+	}
+	if v := Volume(s); v != 12 {
+		t.Errorf("want volume 12 but get %v", v)
+	}
+
+	hole := HyperBox[int]{closedOpen(2, 4), closedOpen(0, 1), closedOpen(0, 1)}
+	if !s.Remove(hole) {
+		t.Errorf("want Remove changed %s", s)
+	}
+	if w := "{[0, 2) x [0, 2) x [0, 1), [2, 4) x [1, 2) x [0, 1), [4, 6) x [0, 2) x [0, 1)}"; s.String() != w {
+		t.Errorf("want %s but get %s", w, s)
+	}
+	if s.Contains(a) || !s.Overlaps(a) || s.Overlaps(hole) {
+		t.Errorf("want %s overlaps but not contains %s", s, a)
+	}
+	if empty := (HyperBox[int]{closedOpen(0, 0), closedOpen(100, 200), closedOpen(0, 1)}); !s.Contains(empty) || !(HyperBoxSet[int]{}).Contains(empty) {
+		t.Errorf("want %s contained by any hyper box set", empty)
+	}
+	if w := (HyperBox[int]{closedOpen(0, 6), closedOpen(0, 2), closedOpen(0, 1)}); !s.Bound().Equal(w) {
+		t.Errorf("want bound %s but get %s", w, s.Bound())
+	}
+	if v := Volume(s); v != 10 {
+		t.Errorf("want volume 10 but get %v", v)
+	}
+	if !s.Union(NewHyperBoxSet(hole)).Equal(NewHyperBoxSet(a, b)) {
+		t.Errorf("want %s with the hole filled equals %s", s, NewHyperBoxSet(a, b))
+	}
+	if i := s.Intersect(NewHyperBoxSet(hole)); !i.IsEmpty() {
+		t.Errorf("want empty but get %s", i)
+	}
+	if d := NewHyperBoxSet(a, b).Difference(s); !d.Equal(NewHyperBoxSet(hole)) {
+		t.Errorf("want %s but get %s", NewHyperBoxSet(hole), d)
+	}
+
+	for name, f := range map[string]func(){
+		"add":    func() { s.Add(HyperBox[int]{closedOpen(0, 1)}) },
+		"remove": func() { s.Remove(HyperBox[int]{closedOpen(0, 1)}) },
+		"union":  func() { s.Union(NewHyperBoxSet(HyperBox[int]{closedOpen(0, 1)})) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("want %s of another dimension panics", name)
+				}
+			}()
+			f()
+		}()
+	}
+	if s.Contains(HyperBox[int]{closedOpen(0, 1)}) || s.Overlaps(HyperBox[int]{closedOpen(0, 1)}) {
+		t.Errorf("want %s has no point of another dimension", s)
+	}
+
+	line := NewHyperBoxSet(HyperBox[int]{{UnboundedBegin: true, UnboundedEnd: true}, point(0)})
+	if v := Volume(line); v != 0 {
+		t.Errorf("want volume 0 but get %v", v)
+	}
+	plane := NewHyperBoxSet(HyperBox[int]{{UnboundedBegin: true, UnboundedEnd: true}, closedOpen(0, 1)})
+	if v := Volume(plane); !math.IsInf(v, 1) {
+		t.Errorf("want volume +Inf but get %v", v)
+	}
+}
+
+// TestHyperBoxSetRandom compares three dimensional hyper box sets with a
+// grid of the points with half coordinates, and the volumes with the
+// number of unit cells.
+func TestHyperBoxSetRandom(t *testing.T) {
+	const size = 4
+	r := rand.New(rand.NewSource(1))
+	randInterval := func() Interval[float64] {
+		a, b := float64(r.Intn(size)), float64(r.Intn(size))
+		return Interval[float64]{Begin: min(a, b), IncBegin: r.Intn(2) == 0, End: max(a, b), IncEnd: r.Intn(2) == 0}
+	}
+	randBox := func() HyperBox[float64] {
+		return HyperBox[float64]{randInterval(), randInterval(), randInterval()}
+	}
+	type grid [2 * size][2 * size][2 * size]bool
+	points := func(f func(i, j, k int)) {
+		for i := 0; i < 2*size; i++ {
+			for j := 0; j < 2*size; j++ {
+				for k := 0; k < 2*size; k++ {
+					f(i, j, k)
+				}
+			}
+		}
+	}
+	fill := func(g *grid, b HyperBox[float64], v bool) {
+		points(func(i, j, k int) {
+			if b.Contains(HyperBox[float64]{point(float64(i) / 2), point(float64(j) / 2), point(float64(k) / 2)}) {
+				g[i][j][k] = v
+			}
+		})
+	}
+	check := func(s HyperBoxSet[float64], g grid) {
+		t.Helper()
+		cells := 0
+		points(func(i, j, k int) {
+			x, y, z := float64(i)/2, float64(j)/2, float64(k)/2
+			if s.ContainsPoint(x, y, z) != g[i][j][k] {
+				t.Fatalf("want ContainsPoint(%v, %v, %v) = %v in %s", x, y, z, g[i][j][k], s)
+			}
+			if i%2 == 1 && j%2 == 1 && k%2 == 1 && g[i][j][k] {
+				cells++
+			}
+		})
+		if v := Volume(s); v != float64(cells) {
+			t.Fatalf("want volume %d but get %v of %s", cells, v, s)
+		}
+	}
+
+	for n := 0; n < 100; n++ {
+		var s, x HyperBoxSet[float64]
+		var gs, gx grid
+		var boxes []HyperBox[float64]
+		for k := 0; k < 5; k++ {
+			b := randBox()
+			boxes = append(boxes, b)
+			s.Add(b)
+			fill(&gs, b, true)
+			c := randBox()
+			x.Add(c)
+			fill(&gx, c, true)
+		}
+		check(s, gs)
+		// the decomposition is canonical, whatever the order of boxes.
+		reversed := slices.Clone(boxes)
+		slices.Reverse(reversed)
+		if r := NewHyperBoxSet(reversed...); !r.Equal(s) {
+			t.Fatalf("want %s but get %s", s, r)
+		}
+
+		var union, intersect, subtract grid
+		points(func(i, j, k int) {
+			union[i][j][k] = gs[i][j][k] || gx[i][j][k]
+			intersect[i][j][k] = gs[i][j][k] && gx[i][j][k]
+			subtract[i][j][k] = gs[i][j][k] && !gx[i][j][k]
+		})
+		check(s.Union(x), union)
+		check(s.Intersect(x), intersect)
+		check(s.Subtract(x), subtract)
+
+		b := randBox()
+		s.Remove(b)
+		fill(&gs, b, false)
+		check(s, gs)
+	}
+}
+
+func TestHyperBoxIndex(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randInterval := func() Interval[int] {
+		a := r.Intn(100)
+		return closedOpen(a, a+r.Intn(20))
+	}
+	boxes := make([]HyperBox[int], 500)
+	for n := range boxes {
+		boxes[n] = HyperBox[int]{randInterval(), randInterval(), randInterval(), randInterval()}
+	}
+	idx := NewHyperBoxIndex(boxes...)
+	want := 0
+	for _, b := range boxes {
+		if !b.IsEmpty() {
+			want++
+		}
+	}
+	if idx.Len() != want {
+		t.Errorf("want %d boxes but get %d", want, idx.Len())
+	}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("want boxes of different dimensions panic")
+			}
+		}()
+		NewHyperBoxIndex(HyperBox[int]{closedOpen(0, 1)}, HyperBox[int]{closedOpen(0, 1), closedOpen(0, 1)})
+	}()
+
+	for n := 0; n < 100; n++ {
+		q := HyperBox[int]{randInterval(), randInterval(), randInterval(), randInterval()}
+		var w, get []int
+		for i, b := range boxes {
+			if b.Overlaps(q) {
+				w = append(w, i)
+			}
+		}
+		for i, b := range idx.Search(q) {
+			if !b.Equal(boxes[i]) {
+				t.Fatalf("want box %d %s but get %s", i, boxes[i], b)
+			}
+			get = append(get, i)
+		}
+		slices.Sort(get)
+		if !slices.Equal(w, get) {
+			t.Fatalf("want %v overlap %s but get %v", w, q, get)
+		}
+	}
+
+	// the index keeps its own copy of the boxes.
+	b := HyperBox[int]{closedOpen(0, 2), closedOpen(0, 2)}
+	kept := NewHyperBoxIndex(b)
+	b[0] = closedOpen(10, 12)
+	for _, get := range kept.Search(HyperBox[int]{closedOpen(1, 2), closedOpen(1, 2)}) {
+		if w := (HyperBox[int]{closedOpen(0, 2), closedOpen(0, 2)}); !get.Equal(w) {
+			t.Errorf("want %s but get %s", w, get)
+		}
+		return
+	}
+	t.Errorf("want a box modified after NewHyperBoxIndex still found")
+}
@@ -1,51 +1,71 @@
 package interval
 
 import (
-	"fmt"
+	"cmp"
 	"strings"
 )
 
-type Interval struct {
+// Interval is an interval over any ordered endpoint type.
+type Interval[T cmp.Ordered] struct {
 	// begin of this interval.
-	Begin int
+	Begin T
 	// if IncBegin is true, this interval is inclusive of the Begin point.
 	IncBegin bool
+	// if UnboundedBegin is true, this interval extends to -inf, Begin and
+	// IncBegin are ignored.
+	UnboundedBegin bool
 
 	// end of this interval.
-	End int
+	End T
 	// if IncEnd is true, this interval is inclusive of the End point.
 	IncEnd bool
+	// if UnboundedEnd is true, this interval extends to +inf, End and
+	// IncEnd are ignored.
+	UnboundedEnd bool
 }
 
-func (i Interval) String() string {
+// IntInterval is an interval with int endpoints.
+type IntInterval = Interval[int]
+
+func (i Interval[T]) String() string {
 	var b strings.Builder
-	if i.IncBegin {
-		b.WriteByte('[')
+	if i.UnboundedBegin {
+		b.WriteString("(-inf")
 	} else {
-
-		b.WriteByte('(')
+		if i.IncBegin {
+			b.WriteByte('[')
+		} else {
+			b.WriteByte('(')
+		}
+		b.WriteString(formatValue(i.Begin))
 	}
-	fmt.Fprintf(&b, "%d", i.Begin)
 	b.WriteString(", ")
-	fmt.Fprintf(&b, "%d", i.End)
-	if i.IncEnd {
-		b.WriteByte(']')
+	if i.UnboundedEnd {
+		b.WriteString("+inf)")
 	} else {
-		b.WriteByte(')')
+		b.WriteString(formatValue(i.End))
+		if i.IncEnd {
+			b.WriteByte(']')
+		} else {
+			b.WriteByte(')')
+		}
 	}
 	return b.String()
 }
 
 // Equal returns true if receiver interval is equals x interval.
-func (i Interval) Equal(x Interval) bool {
-	return (i.Begin == x.Begin &&
-		i.End == x.End &&
-		i.IncBegin == x.IncBegin &&
-		i.IncEnd == x.IncEnd) || (x.IsEmpty() && i.IsEmpty())
+func (i Interval[T]) Equal(x Interval[T]) bool {
+	if x.IsEmpty() || i.IsEmpty() {
+		return x.IsEmpty() && i.IsEmpty()
+	}
+	return compareBegin(i, x) == 0 && compareEnd(i, x) == 0
 }
 
 // IsEmpty returns true if receiver interval has no value.
-func (i Interval) IsEmpty() bool {
+func (i Interval[T]) IsEmpty() bool {
+	if i.UnboundedBegin || i.UnboundedEnd {
+		return false
+	}
 	if i.Begin < i.End {
 		return false
 	} else if i.Begin == i.End {
@@ -54,14 +74,65 @@ func (i Interval) IsEmpty() bool {
 	return true
 }
 
+// compareBegin compares the begin of i with the begin of x,
+// an inclusive begin is before an exclusive begin at the same point.
+func compareBegin[T cmp.Ordered](i, x Interval[T]) int {
+	switch {
+	case i.UnboundedBegin && x.UnboundedBegin:
+		return 0
+	case i.UnboundedBegin:
+		return -1
+	case x.UnboundedBegin:
+		return 1
+	}
+	if c := cmp.Compare(i.Begin, x.Begin); c != 0 {
+		return c
+	}
+	switch {
+	case i.IncBegin == x.IncBegin:
+		return 0
+	case i.IncBegin:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// compareEnd compares the end of i with the end of x,
+// an exclusive end is before an inclusive end at the same point.
+func compareEnd[T cmp.Ordered](i, x Interval[T]) int {
+	switch {
+	case i.UnboundedEnd && x.UnboundedEnd:
+		return 0
+	case i.UnboundedEnd:
+		return 1
+	case x.UnboundedEnd:
+		return -1
+	}
+	if c := cmp.Compare(i.End, x.End); c != 0 {
+		return c
+	}
+	switch {
+	case i.IncEnd == x.IncEnd:
+		return 0
+	case i.IncEnd:
+		return 1
+	default:
+		return -1
+	}
+}
+
 // LtBeginOf returns true if receiver interval is less than begin of x interval.
-func (i Interval) LtBeginOf(x Interval) bool {
+func (i Interval[T]) LtBeginOf(x Interval[T]) bool {
 	if x.IsEmpty() {
 		return false
 	}
 	if i.IsEmpty() {
 		return false
 	}
+	if i.UnboundedEnd || x.UnboundedBegin {
+		return false
+	}
 	if i.End < x.Begin {
 		return true
 	} else if i.End == x.Begin {
@@ -71,147 +142,143 @@ func (i Interval) LtBeginOf(x Interval) bool {
 }
 
 // LeEndOf returns true if receiver interval is less than or euqal to end of x interval.
-func (i Interval) LeEndOf(x Interval) bool {
+func (i Interval[T]) LeEndOf(x Interval[T]) bool {
 	if x.IsEmpty() {
 		return false
 	}
 	if i.IsEmpty() {
 		return false
 	}
-	if i.End < x.End {
-		return true
-	} else if i.End == x.End {
-		return !i.IncEnd || x.IncEnd
-	}
-	return false
+	return compareEnd(i, x) <= 0
 }
 
 // Contains returns true if x interval is completely covered by receiver interval.
-func (i Interval) Contains(x Interval) bool {
+func (i Interval[T]) Contains(x Interval[T]) bool {
 	if x.IsEmpty() {
 		return true
 	}
 	if i.IsEmpty() {
 		return false
 	}
-	if i.Begin > x.Begin {
-		return false
-	}
-	if i.End < x.End {
-		return false
-	}
-	if i.Begin < x.Begin && i.End > x.End {
-		return true
-	}
-	if i.Begin == x.Begin && (i.IncBegin || !x.IncBegin) {
-		return true
-	}
-	return i.End == x.End && (i.IncEnd || !x.IncEnd)
+	return compareBegin(i, x) <= 0 && compareEnd(i, x) >= 0
 }
 
 // Intersect returns the intersection of receiver interval with x interval.
-func (i Interval) Intersect(x Interval) Interval {
+func (i Interval[T]) Intersect(x Interval[T]) Interval[T] {
 	if x.IsEmpty() || i.IsEmpty() {
-		return Interval{}
+		return Interval[T]{}
 	}
-	if i.Begin > x.Begin {
+	if compareBegin(i, x) > 0 {
 		x.Begin = i.Begin
 		x.IncBegin = i.IncBegin
-	} else if i.Begin == x.Begin && !i.IncBegin {
-		x.IncBegin = false
+		x.UnboundedBegin = i.UnboundedBegin
 	}
-	if i.End < x.End {
+	if compareEnd(i, x) < 0 {
 		x.End = i.End
 		x.IncEnd = i.IncEnd
-	} else if i.End == x.End && !i.IncEnd {
-		x.IncEnd = false
+		x.UnboundedEnd = i.UnboundedEnd
 	}
 	return maybeEmpty(x)
 }
 
-func maybeEmpty(x Interval) Interval {
+func maybeEmpty[T cmp.Ordered](x Interval[T]) Interval[T] {
 	if x.IsEmpty() {
-		return Interval{}
+		return Interval[T]{}
 	}
 	return x
 }
 
 // Move returns an interval that adds number x to begin and end of receiver interval.
-func (i Interval) Move(x int) Interval {
+// Unbounded endpoints stay unbounded.
+// Move does not detect overflow of integer endpoints, use MoveChecked or
+// MoveSaturating near the bounds of T.
+func (i Interval[T]) Move(x T) Interval[T] {
 	if i.IsEmpty() {
-		return Interval{}
+		return Interval[T]{}
+	}
+	if !i.UnboundedBegin {
+		i.Begin += x
 	}
-	return Interval{
-		Begin:    i.Begin + x,
-		IncBegin: i.IncBegin,
-		End:      i.End + x,
-		IncEnd:   i.IncEnd,
+	if !i.UnboundedEnd {
+		i.End += x
 	}
+	return i
 }
 
 // Bisect returns two intervals, one on the before of x and one on the
 // after of x, corresponding to the subtraction of x from the receiver
 // interval. The returned intervals are always within the range of the
 // receiver interval.
-func (i Interval) Bisect(x Interval) (Interval, Interval) {
+func (i Interval[T]) Bisect(x Interval[T]) (Interval[T], Interval[T]) {
 	in := i.Intersect(x)
 	if in.IsEmpty() {
 		if i.LtBeginOf(x) {
-			return i, Interval{}
+			return i, Interval[T]{}
 		}
-		return Interval{}, i
-	}
-	return maybeEmpty(Interval{
-			Begin:    i.Begin,
-			IncBegin: i.IncBegin,
-			End:      in.Begin,
-			IncEnd:   !in.IncBegin,
-		}), maybeEmpty(Interval{
-			Begin:    in.End,
-			IncBegin: !in.IncEnd,
-			End:      i.End,
-			IncEnd:   i.IncEnd,
+		return Interval[T]{}, i
+	}
+	var left, right Interval[T]
+	if !in.UnboundedBegin {
+		left = maybeEmpty(Interval[T]{
+			Begin:          i.Begin,
+			IncBegin:       i.IncBegin,
+			UnboundedBegin: i.UnboundedBegin,
+			End:            in.Begin,
+			IncEnd:         !in.IncBegin,
+		})
+	}
+	if !in.UnboundedEnd {
+		right = maybeEmpty(Interval[T]{
+			Begin:        in.End,
+			IncBegin:     !in.IncEnd,
+			End:          i.End,
+			IncEnd:       i.IncEnd,
+			UnboundedEnd: i.UnboundedEnd,
 		})
+	}
+	return left, right
 }
 
 // Adjoin returns the union of two intervals, if the intervals are exactly
 // adjacent, or the zero interval if they are not.
-func (i Interval) Adjoin(x Interval) Interval {
+func (i Interval[T]) Adjoin(x Interval[T]) Interval[T] {
 	if x.IsEmpty() || i.IsEmpty() {
-		return Interval{}
+		return Interval[T]{}
 	}
-	if i.Begin == x.End && (i.IncBegin || x.IncEnd) {
+	if !i.UnboundedBegin && !x.UnboundedEnd &&
+		i.Begin == x.End && (i.IncBegin || x.IncEnd) {
 		x.End = i.End
 		x.IncEnd = i.IncEnd
+		x.UnboundedEnd = i.UnboundedEnd
 		return x
 	}
-	if i.End == x.Begin && (i.IncEnd || x.IncBegin) {
+	if !i.UnboundedEnd && !x.UnboundedBegin &&
+		i.End == x.Begin && (i.IncEnd || x.IncBegin) {
 		x.Begin = i.Begin
 		x.IncBegin = i.IncBegin
+		x.UnboundedBegin = i.UnboundedBegin
 		return x
 	}
-	return Interval{}
+	return Interval[T]{}
 }
 
 // Encompass returns an interval that covers the exact extents of two intervals.
-func (i Interval) Encompass(x Interval) Interval {
+func (i Interval[T]) Encompass(x Interval[T]) Interval[T] {
 	if x.IsEmpty() {
 		return i
 	}
 	if i.IsEmpty() {
 		return x
 	}
-	if i.Begin < x.Begin {
+	if compareBegin(i, x) < 0 {
 		x.Begin = i.Begin
 		x.IncBegin = i.IncBegin
-	} else if i.Begin == x.Begin && i.IncBegin {
-		x.IncBegin = true
+		x.UnboundedBegin = i.UnboundedBegin
 	}
-	if i.End > x.End {
+	if compareEnd(i, x) > 0 {
 		x.End = i.End
 		x.IncEnd = i.IncEnd
-	} else if i.End == x.End && i.IncEnd {
-		x.IncEnd = true
+		x.UnboundedEnd = i.UnboundedEnd
 	}
 	return x
 }
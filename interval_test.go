@@ -511,19 +511,111 @@ var testIntervals = []struct {
 
 		o: "================",
 	},
+	{ // 26
+		i: "*=====",
+		x: "======",
+		a: false,
+		b: false,
+		c: false,
+		d: true,
+		e: "*=====",
+
+		g: "",
+		h: "",
+		j: "=",
+		k: "",
+
+		l: "",
+
+		o: "======",
+	},
+	{ // 27
+		i: "<====",
+		x: "------=====>",
+		a: true,
+		b: false,
+		c: false,
+		d: false,
+		e: "",
+
+		g: "<====",
+		h: "",
+		j: "",
+		k: "------=====>",
+
+		l: "",
+
+		o: "<>",
+	},
+	{ // 28
+		i: "<=====",
+		x: "---=====>",
+		a: false,
+		b: false,
+		c: false,
+		d: false,
+		e: "---===",
+
+		g: "<==*",
+		h: "",
+		j: "",
+		k: "-----*>",
+
+		l: "",
+
+		o: "<>",
+	},
+	{ // 29
+		i: "<====*",
+		x: "-----=====>",
+		a: true,
+		b: false,
+		c: false,
+		d: false,
+		e: "",
+
+		g: "<====*",
+		h: "",
+		j: "",
+		k: "-----=====>",
+
+		l: "<>",
+
+		o: "<>",
+	},
+	{ // 30
+		i: "<>",
+		x: "---===",
+		a: false,
+		b: false,
+		c: true,
+		d: false,
+		e: "---===",
+
+		g: "<==*",
+		h: "-----*>",
+		j: "",
+		k: "",
+
+		l: "",
+
+		o: "<>",
+	},
 }
 
-func parseInterval(s string) Interval {
+func parseInterval(s string) Interval[int] {
 	if s == "" {
-		return Interval{}
+		return Interval[int]{}
 	}
-	begin := strings.IndexAny(s, "*=")
-	end := strings.LastIndexAny(s, "*=")
-	return Interval{
-		Begin:    begin,
-		IncBegin: s[begin] == '=',
-		End:      end,
-		IncEnd:   s[end] == '=',
+	begin := strings.IndexAny(s, "*=<>")
+	end := strings.LastIndexAny(s, "*=<>")
+	return Interval[int]{
+		Begin:          begin,
+		IncBegin:       s[begin] != '*',
+		UnboundedBegin: s[begin] == '<',
+		End:            end,
+		IncEnd:         s[end] != '*',
+		UnboundedEnd:   s[end] == '>',
 	}
 }
 
@@ -589,3 +681,57 @@ func TestInterval(t *testing.T) {
 		})
 	}
 }
+
+func TestInterval_Ordered(t *testing.T) {
+	f := Interval[float64]{Begin: 0.5, IncBegin: true, End: 1.5}
+	if !f.Contains(Interval[float64]{Begin: 1, IncBegin: true, End: 1.25, IncEnd: true}) {
+		t.Errorf("want %s contains [1, 1.25]", f)
+	}
+	if s := f.String(); s != "[0.5, 1.5)" {
+		t.Errorf("want %s.String() = [0.5, 1.5) but get %s", f, s)
+	}
+
+	s := Interval[string]{Begin: "a", IncBegin: true, End: "m"}
+	left, right := s.Bisect(Interval[string]{Begin: "c", IncBegin: true, End: "d"})
+	wl := Interval[string]{Begin: "a", IncBegin: true, End: "c"}
+	wr := Interval[string]{Begin: "d", IncBegin: true, End: "m"}
+	if !left.Equal(wl) || !right.Equal(wr) {
+		t.Errorf("want %s.Bisect([c, d)) = %s, %s but get %s, %s", s, wl, wr, left, right)
+	}
+}
+
+func TestInterval_Unbounded(t *testing.T) {
+	var unboundedCases = []struct {
+		i string
+		m int
+		w string
+	}{
+		{ // 0
+			i: "<>",
+			m: 5,
+			w: "(-inf, +inf)",
+		},
+		{ // 1
+			i: "<====",
+			m: 5,
+			w: "(-inf, 9]",
+		},
+		{ // 2
+			i: "---*=>",
+			m: -3,
+			w: "(0, +inf)",
+		},
+	}
+	for n, tc := range unboundedCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			i := parseInterval(tc.i)
+			if i.IsEmpty() {
+				t.Errorf("want %s is not empty", i)
+			}
+			m := i.Move(tc.m)
+			if s := m.String(); s != tc.w {
+				t.Errorf("want %s.Move(%d) = %s but get %s", i, tc.m, tc.w, s)
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+package interval
+
+import (
+	"net/netip"
+	"strings"
+)
+
+// IPRange is the range of IP addresses from From to To, both inclusive.
+type IPRange struct {
+	From, To netip.Addr
+}
+
+// String returns the range in the form "192.168.0.1-192.168.0.9".
+func (r IPRange) String() string {
+	return r.From.String() + "-" + r.To.String()
+}
+
+// Prefixes returns the minimal list of CIDR prefixes covering exactly the
+// addresses of receiver range, in order.
+func (r IPRange) Prefixes() []netip.Prefix {
+	from, to := r.From.WithZone(""), r.To.WithZone("")
+	if !from.IsValid() || from.BitLen() != to.BitLen() || to.Less(from) {
+		return nil
+	}
+	var prefixes []netip.Prefix
+	for {
+		// the shortest prefix that begins at from and ends within the range.
+		var p netip.Prefix
+		var last netip.Addr
+		for bits := 0; bits <= from.BitLen(); bits++ {
+			p = netip.PrefixFrom(from, bits)
+			if p.Masked().Addr() != from {
+				continue
+			}
+			if last = lastAddr(p); !to.Less(last) {
+				break
+			}
+		}
+		prefixes = append(prefixes, p)
+		if last == to {
+			return prefixes
+		}
+		from = last.Next()
+	}
+}
+
+// lastAddr returns the last address of a masked prefix.
+func lastAddr(p netip.Prefix) netip.Addr {
+	b := p.Addr().AsSlice()
+	for n := p.Bits(); n < len(b)*8; n++ {
+		b[n/8] |= 0x80 >> (n % 8)
+	}
+	a, _ := netip.AddrFromSlice(b)
+	return a
+}
+
+// ipKey is an address encoded as a string that sorts in the same order as
+// netip.Addr.Less: the 4 bytes of an IPv4 address or the 16 bytes of an
+// IPv6 address, the two are never compared with each other.
+type ipKey string
+
+func newIPKey(a netip.Addr) ipKey {
+	return ipKey(a.AsSlice())
+}
+
+func (k ipKey) addr() netip.Addr {
+	a, _ := netip.AddrFromSlice([]byte(k))
+	return a
+}
+
+// ipInterval returns the range from from to to as an interval of keys in the
+// half-open form [from, to.Next()) of Canonical, or [from, to] if to is the
+// last address, so adjacent ranges are merged.
+func ipInterval(from, to netip.Addr) Interval[ipKey] {
+	i := Interval[ipKey]{Begin: newIPKey(from), IncBegin: true, End: newIPKey(to), IncEnd: true}
+	if next := to.Next(); next.IsValid() {
+		i.End, i.IncEnd = newIPKey(next), false
+	}
+	return i
+}
+
+func ipRangeOf(i Interval[ipKey]) IPRange {
+	r := IPRange{From: i.Begin.addr(), To: i.End.addr()}
+	if !i.IncEnd {
+		r.To = r.To.Prev()
+	}
+	return r
+}
+
+// IPSet is a set of IP addresses, it uses the OrderedSet algorithms.
+// IPv4 and IPv6 addresses are kept in separate spaces, so 1.2.3.4 and
+// ::ffff:1.2.3.4 are different addresses, and zones are ignored.
+type IPSet struct {
+	v4, v6 OrderedSet[ipKey]
+}
+
+// space returns the ordered set of the addresses of a, or nil for an
+// invalid address.
+func (s *IPSet) space(a netip.Addr) *OrderedSet[ipKey] {
+	switch {
+	case a.Is4():
+		return &s.v4
+	case a.Is6():
+		return &s.v6
+	}
+	return nil
+}
+
+// Copy returns a copy of an ip set that without affecting the original.
+func (s IPSet) Copy() IPSet {
+	return IPSet{s.v4.Copy(), s.v6.Copy()}
+}
+
+// IsEmpty returns true if no addresses in this ip set.
+func (s IPSet) IsEmpty() bool {
+	return s.v4.IsEmpty() && s.v6.IsEmpty()
+}
+
+func (s IPSet) Equal(x IPSet) bool {
+	return s.v4.Equal(x.v4) && s.v6.Equal(x.v6)
+}
+
+func (s IPSet) String() string {
+	var b strings.Builder
+	b.WriteByte('{')
+	for n, r := range s.Ranges() {
+		if n > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(r.String())
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// Ranges returns the ranges of this ip set in order, IPv4 before IPv6.
+func (s IPSet) Ranges() []IPRange {
+	ranges := make([]IPRange, 0, s.v4.Len()+s.v6.Len())
+	for _, i := range s.v4.intervals {
+		ranges = append(ranges, ipRangeOf(i))
+	}
+	for _, i := range s.v6.intervals {
+		ranges = append(ranges, ipRangeOf(i))
+	}
+	return ranges
+}
+
+// Prefixes returns the minimal list of CIDR prefixes covering exactly the
+// addresses of this ip set, in order.
+func (s IPSet) Prefixes() []netip.Prefix {
+	var prefixes []netip.Prefix
+	for _, r := range s.Ranges() {
+		prefixes = append(prefixes, r.Prefixes()...)
+	}
+	return prefixes
+}
+
+// rangeOf returns the ordered set and the interval of the addresses from
+// from to to, the set is nil if they are not a valid range.
+func (s *IPSet) rangeOf(from, to netip.Addr) (*OrderedSet[ipKey], Interval[ipKey]) {
+	from, to = from.WithZone(""), to.WithZone("")
+	set := s.space(from)
+	if set == nil || set != s.space(to) || to.Less(from) {
+		return nil, Interval[ipKey]{}
+	}
+	return set, ipInterval(from, to)
+}
+
+func prefixRange(p netip.Prefix) (netip.Addr, netip.Addr) {
+	p = p.Masked()
+	if !p.IsValid() {
+		return netip.Addr{}, netip.Addr{}
+	}
+	return p.Addr(), lastAddr(p)
+}
+
+// AddRange adds the addresses from from to to, both inclusive, to this ip
+// set. AddRange returns true if this ip set changed.
+func (s *IPSet) AddRange(from, to netip.Addr) bool {
+	set, i := s.rangeOf(from, to)
+	if set == nil {
+		return false
+	}
+	return set.Add(i)
+}
+
+// RemoveRange removes the addresses from from to to, both inclusive, from
+// this ip set. RemoveRange returns true if this ip set changed.
+func (s *IPSet) RemoveRange(from, to netip.Addr) bool {
+	set, i := s.rangeOf(from, to)
+	if set == nil {
+		return false
+	}
+	return set.Remove(i)
+}
+
+// AddPrefix adds the addresses of p to this ip set.
+// AddPrefix returns true if this ip set changed.
+func (s *IPSet) AddPrefix(p netip.Prefix) bool {
+	return s.AddRange(prefixRange(p))
+}
+
+// RemovePrefix removes the addresses of p from this ip set.
+// RemovePrefix returns true if this ip set changed.
+func (s *IPSet) RemovePrefix(p netip.Prefix) bool {
+	return s.RemoveRange(prefixRange(p))
+}
+
+// ContainsAddr returns true if a is in this ip set.
+func (s IPSet) ContainsAddr(a netip.Addr) bool {
+	return s.ContainsRange(a, a)
+}
+
+// ContainsRange returns true if every address from from to to is in this
+// ip set.
+func (s IPSet) ContainsRange(from, to netip.Addr) bool {
+	set, i := s.rangeOf(from, to)
+	if set == nil {
+		return false
+	}
+	return set.Contains(i)
+}
+
+// ContainsPrefix returns true if every address of p is in this ip set.
+func (s IPSet) ContainsPrefix(p netip.Prefix) bool {
+	return s.ContainsRange(prefixRange(p))
+}
+
+// OverlapsPrefix returns true if any address of p is in this ip set, such
+// as a new CIDR block that overlaps the allocated ones.
+func (s IPSet) OverlapsPrefix(p netip.Prefix) bool {
+	set, i := s.rangeOf(prefixRange(p))
+	if set == nil {
+		return false
+	}
+	return set.Overlaps(NewOrderedSet(i))
+}
+
+// Union returns an ip set containing all addresses in s or x.
+func (s IPSet) Union(x IPSet) IPSet {
+	return IPSet{Union(s.v4, x.v4), Union(s.v6, x.v6)}
+}
+
+// Intersect returns an ip set containing all addresses of s that also belong to x.
+func (s IPSet) Intersect(x IPSet) IPSet {
+	return IPSet{Intersect(s.v4, x.v4), Intersect(s.v6, x.v6)}
+}
+
+// Subtract returns an ip set containing all addresses in s but not in x.
+func (s IPSet) Subtract(x IPSet) IPSet {
+	return IPSet{Subtract(s.v4, x.v4), Subtract(s.v6, x.v6)}
+}
+
+// Difference returns an ip set containing all addresses in either of s and x,
+// but not in their intersection.
+func (s IPSet) Difference(x IPSet) IPSet {
+	return IPSet{Difference(s.v4, x.v4), Difference(s.v6, x.v6)}
+}
@@ -0,0 +1,182 @@
+package interval
+
+import (
+	"fmt"
+	"math/rand"
+	"net/netip"
+	"slices"
+	"testing"
+)
+
+func TestIPRange_Prefixes(t *testing.T) {
+	var prefixesCases = []struct {
+		r IPRange
+		w []string
+	}{
+		{ // 0
+			r: IPRange{netip.MustParseAddr("10.0.0.0"), netip.MustParseAddr("10.0.1.255")},
+			w: []string{"10.0.0.0/23"},
+		},
+		{ // 1
+			r: IPRange{netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("10.0.0.6")},
+			w: []string{"10.0.0.1/32", "10.0.0.2/31", "10.0.0.4/31", "10.0.0.6/32"},
+		},
+		{ // 2
+			r: IPRange{netip.MustParseAddr("0.0.0.0"), netip.MustParseAddr("255.255.255.255")},
+			w: []string{"0.0.0.0/0"},
+		},
+		{ // 3
+			r: IPRange{netip.MustParseAddr("255.255.255.254"), netip.MustParseAddr("255.255.255.255")},
+			w: []string{"255.255.255.254/31"},
+		},
+		{ // 4
+			r: IPRange{netip.MustParseAddr("2001:db8::"), netip.MustParseAddr("2001:db8::1:0")},
+			w: []string{"2001:db8::/112", "2001:db8::1:0/128"},
+		},
+		{ // 5
+			r: IPRange{netip.MustParseAddr("10.0.0.2"), netip.MustParseAddr("10.0.0.1")},
+			w: nil,
+		},
+		{ // 6
+			r: IPRange{netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("::1")},
+			w: nil,
+		},
+	}
+	for n, tc := range prefixesCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			var get []string
+			for _, p := range tc.r.Prefixes() {
+				get = append(get, p.String())
+			}
+			if !slices.Equal(get, tc.w) {
+				t.Errorf("want %s prefixes %v but get %v", tc.r, tc.w, get)
+			}
+		})
+	}
+}
+
+func TestIPRange_PrefixesRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	addr := func() netip.Addr {
+		return netip.AddrFrom4([4]byte{10, 0, byte(r.Intn(4)), byte(r.Intn(256))})
+	}
+	for n := 0; n < 1000; n++ {
+		a, b := addr(), addr()
+		if b.Less(a) {
+			a, b = b, a
+		}
+		prefixes := IPRange{a, b}.Prefixes()
+		next := a
+		for k, p := range prefixes {
+			if p.Addr() != next || p.Masked() != p {
+				t.Fatalf("want %d-th prefix of %s-%s begins at %s but get %s", k, a, b, next, p)
+			}
+			// two prefixes are only minimal if they are not the halves of
+			// a shorter prefix.
+			if k > 0 {
+				prev := prefixes[k-1]
+				if prev.Bits() == p.Bits() && netip.PrefixFrom(prev.Addr(), p.Bits()-1).Masked().Addr() == prev.Addr() {
+					t.Fatalf("want minimal prefixes of %s-%s but get %v", a, b, prefixes)
+				}
+			}
+			next = lastAddr(p).Next()
+		}
+		if next != b.Next() {
+			t.Fatalf("want prefixes of %s-%s end at %s but get %v", a, b, b, prefixes)
+		}
+	}
+}
+
+func TestIPSet(t *testing.T) {
+	var s IPSet
+	if !s.AddPrefix(netip.MustParsePrefix("10.0.0.0/24")) || !s.AddPrefix(netip.MustParsePrefix("10.0.1.7/24")) {
+		t.Fatalf("want AddPrefix changed %s", s)
+	}
+	if !s.AddPrefix(netip.MustParsePrefix("2001:db8::/32")) {
+		t.Fatalf("want AddPrefix changed %s", s)
+	}
+	if s.AddPrefix(netip.MustParsePrefix("10.0.0.128/25")) {
+		t.Errorf("want AddPrefix of a covered prefix not changed %s", s)
+	}
+	if s.AddPrefix(netip.Prefix{}) || s.AddRange(netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("::1")) {
+		t.Errorf("want invalid ranges not changed %s", s)
+	}
+	if w := "{10.0.0.0-10.0.1.255, 2001:db8::-2001:db8:ffff:ffff:ffff:ffff:ffff:ffff}"; s.String() != w {
+		t.Errorf("want %s but get %s", w, s)
+	}
+	if w, get := "[10.0.0.0/23 2001:db8::/32]", fmt.Sprint(s.Prefixes()); get != w {
+		t.Errorf("want prefixes %s but get %s", w, get)
+	}
+
+	if !s.ContainsAddr(netip.MustParseAddr("10.0.1.255")) || s.ContainsAddr(netip.MustParseAddr("10.0.2.0")) {
+		t.Errorf("want %s contains 10.0.1.255 but not 10.0.2.0", s)
+	}
+	if s.ContainsAddr(netip.MustParseAddr("::ffff:10.0.0.1")) {
+		t.Errorf("want %s not contains an IPv4-mapped IPv6 address", s)
+	}
+	if !s.ContainsAddr(netip.MustParseAddr("2001:db8::1%eth0")) {
+		t.Errorf("want %s contains an address with a zone", s)
+	}
+
+	if !s.RemoveRange(netip.MustParseAddr("10.0.0.10"), netip.MustParseAddr("10.0.0.20")) {
+		t.Errorf("want RemoveRange changed %s", s)
+	}
+	if s.RemovePrefix(netip.MustParsePrefix("192.168.0.0/16")) {
+		t.Errorf("want RemovePrefix of a disjoint prefix not changed %s", s)
+	}
+	if w, get := "[10.0.0.0/29 10.0.0.8/31 10.0.0.21/32 10.0.0.22/31 10.0.0.24/29 10.0.0.32/27 10.0.0.64/26 10.0.0.128/25 10.0.1.0/24 2001:db8::/32]", fmt.Sprint(s.Prefixes()); get != w {
+		t.Errorf("want prefixes %s but get %s", w, get)
+	}
+	if s.ContainsPrefix(netip.MustParsePrefix("10.0.0.0/24")) || !s.OverlapsPrefix(netip.MustParsePrefix("10.0.0.0/24")) {
+		t.Errorf("want %s overlaps but not contains 10.0.0.0/24", s)
+	}
+	if s.OverlapsPrefix(netip.MustParsePrefix("10.0.0.16/30")) {
+		t.Errorf("want %s not overlaps 10.0.0.16/30", s)
+	}
+}
+
+func TestIPSetOperations(t *testing.T) {
+	set := func(prefixes ...string) IPSet {
+		var s IPSet
+		for _, p := range prefixes {
+			s.AddPrefix(netip.MustParsePrefix(p))
+		}
+		return s
+	}
+	pool := set("10.0.0.0/16", "fd00::/64", "255.255.255.0/24")
+	used := set("10.0.1.0/24", "10.0.128.0/17", "fd00::/65", "255.255.255.255/32")
+
+	var operationCases = []struct {
+		name string
+		get  IPSet
+		w    IPSet
+	}{
+		{
+			name: "union",
+			get:  pool.Union(used),
+			w:    pool,
+		},
+		{
+			name: "intersect",
+			get:  pool.Intersect(used),
+			w:    used,
+		},
+		{
+			name: "subtract",
+			get:  pool.Subtract(used),
+			w:    set("10.0.0.0/24", "10.0.2.0/23", "10.0.4.0/22", "10.0.8.0/21", "10.0.16.0/20", "10.0.32.0/19", "10.0.64.0/18", "fd00::8000:0:0:0/65", "255.255.255.0/25", "255.255.255.128/26", "255.255.255.192/27", "255.255.255.224/28", "255.255.255.240/29", "255.255.255.248/30", "255.255.255.252/31", "255.255.255.254/32"),
+		},
+		{
+			name: "difference",
+			get:  used.Difference(pool),
+			w:    pool.Subtract(used),
+		},
+	}
+	for _, tc := range operationCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if !tc.get.Equal(tc.w) {
+				t.Errorf("want %s but get %s", tc.w, tc.get)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package interval
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+// All returns an iterator over all intervals in this ordered set from left
+// to right.
+func (s OrderedSet[T]) All() iter.Seq[Interval[T]] {
+	return func(yield func(Interval[T]) bool) {
+		for _, x := range s.intervals {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over all intervals in this ordered set from
+// right to left.
+func (s OrderedSet[T]) Backward() iter.Seq[Interval[T]] {
+	return func(yield func(Interval[T]) bool) {
+		for n := len(s.intervals) - 1; n >= 0; n-- {
+			if !yield(s.intervals[n]) {
+				return
+			}
+		}
+	}
+}
+
+// Within returns an iterator over the intervals of this ordered set that
+// intersect bound, clipped to bound, from left to right.
+func (s OrderedSet[T]) Within(bound Interval[T]) iter.Seq[Interval[T]] {
+	return func(yield func(Interval[T]) bool) {
+		it := s.Iterator(bound, true)
+		for x := it(); !x.IsEmpty(); x = it() {
+			if in := x.Intersect(bound); !in.IsEmpty() && !yield(in) {
+				return
+			}
+		}
+	}
+}
+
+// Gaps returns an iterator over the intervals in bound but not in this
+// ordered set from left to right.
+func (s OrderedSet[T]) Gaps(bound Interval[T]) iter.Seq[Interval[T]] {
+	return func(yield func(Interval[T]) bool) {
+		complement(s.Iterator(bound, true), bound, yield)
+	}
+}
+
+// Collect returns an ordered set containing all intervals of seq.
+func Collect[T cmp.Ordered](seq iter.Seq[Interval[T]]) OrderedSet[T] {
+	return FromIntervals(slices.Collect(seq))
+}
+
+// The streaming set operations below consume sequences of ordered and
+// non-overlapping intervals, such as the ones returned by All, and produce
+// such a sequence. Both sequences are consumed at most once and only as far
+// as the result is consumed.
+
+// UnionSeq returns an iterator over the intervals in a or b.
+func UnionSeq[T cmp.Ordered](a, b iter.Seq[Interval[T]]) iter.Seq[Interval[T]] {
+	return func(yield func(Interval[T]) bool) {
+		xit, xstop := pull(a)
+		defer xstop()
+		yit, ystop := pull(b)
+		defer ystop()
+
+		push, flush := coalesce(yield)
+		x, y := xit(), yit()
+		for !x.IsEmpty() || !y.IsEmpty() {
+			if y.IsEmpty() || (!x.IsEmpty() && compareBegin(x, y) <= 0) {
+				if !push(x) {
+					return
+				}
+				x = xit()
+			} else {
+				if !push(y) {
+					return
+				}
+				y = yit()
+			}
+		}
+		flush()
+	}
+}
+
+// IntersectSeq returns an iterator over the intervals of a that also belong
+// to b.
+func IntersectSeq[T cmp.Ordered](a, b iter.Seq[Interval[T]]) iter.Seq[Interval[T]] {
+	return func(yield func(Interval[T]) bool) {
+		xit, xstop := pull(a)
+		defer xstop()
+		yit, ystop := pull(b)
+		defer ystop()
+
+		push, flush := coalesce(yield)
+		intersect(xit, yit, push)
+		flush()
+	}
+}
+
+// SubtractSeq returns an iterator over the intervals in a but not in b.
+func SubtractSeq[T cmp.Ordered](a, b iter.Seq[Interval[T]]) iter.Seq[Interval[T]] {
+	return func(yield func(Interval[T]) bool) {
+		xit, xstop := pull(a)
+		defer xstop()
+		yit, ystop := pull(b)
+		defer ystop()
+
+		push, flush := coalesce(yield)
+		subtract(xit, yit, push)
+		flush()
+	}
+}
+
+// DifferenceSeq returns an iterator over the intervals in either of a and b,
+// but not in their intersection.
+func DifferenceSeq[T cmp.Ordered](a, b iter.Seq[Interval[T]]) iter.Seq[Interval[T]] {
+	return func(yield func(Interval[T]) bool) {
+		xit, xstop := pull(a)
+		defer xstop()
+		yit, ystop := pull(b)
+		defer ystop()
+
+		push, flush := coalesce(yield)
+		difference(xit, yit, push)
+		flush()
+	}
+}
+
+// pull converts seq into an iterator in the form returned by
+// OrderedSet.Iterator, empty intervals in seq are skipped.
+func pull[T cmp.Ordered](seq iter.Seq[Interval[T]]) (func() Interval[T], func()) {
+	next, stop := iter.Pull(seq)
+	return func() Interval[T] {
+		for {
+			x, ok := next()
+			if !ok || !x.IsEmpty() {
+				return x
+			}
+		}
+	}, stop
+}
+
+// coalesce returns a push function that merges overlapping and adjacent
+// intervals before passing them to yield, and a flush function that passes
+// the last merged interval.
+func coalesce[T cmp.Ordered](yield func(Interval[T]) bool) (func(Interval[T]) bool, func()) {
+	var pending Interval[T]
+	stopped := false
+	push := func(x Interval[T]) bool {
+		switch {
+		case pending.IsEmpty():
+			pending = x
+		case !pending.Intersect(x).IsEmpty() || !pending.Adjoin(x).IsEmpty():
+			pending = pending.Encompass(x)
+		default:
+			if !yield(pending) {
+				stopped = true
+				return false
+			}
+			pending = x
+		}
+		return true
+	}
+	flush := func() {
+		if !stopped && !pending.IsEmpty() {
+			yield(pending)
+		}
+	}
+	return push, flush
+}
@@ -0,0 +1,203 @@
+package interval
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestOrderedSet_All(t *testing.T) {
+	s := parseOrderedSet("== *=* ===>")
+	w := s.Intervals()
+	if get := slices.Collect(s.All()); !equalIntervals(get, w) {
+		t.Errorf("want All() = %v but get %v", w, get)
+	}
+	slices.Reverse(w)
+	if get := slices.Collect(s.Backward()); !equalIntervals(get, w) {
+		t.Errorf("want Backward() = %v but get %v", w, get)
+	}
+	for x := range s.All() {
+		if !x.Equal(s.intervals[0]) {
+			t.Errorf("want first interval %s but get %s", s.intervals[0], x)
+		}
+		break
+	}
+}
+
+func TestOrderedSet_Within(t *testing.T) {
+	var withinCases = []struct {
+		s string
+		b string
+		w string
+	}{
+		{ // 0
+			s: "",
+			b: "===",
+			w: "",
+		},
+		{ // 1
+			s: "===",
+			b: "",
+			w: "",
+		},
+		{ // 2
+			s: "===   ===  ===",
+			b: " ==========",
+			w: " ==   ===",
+		},
+		{ // 3
+			s: "<==   ===  ===",
+			b: " ====*",
+			w: " ==",
+		},
+		{ // 4
+			s: "===*  ===  ===",
+			b: "   =====*",
+			w: "      ==*",
+		},
+	}
+	for n, tc := range withinCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			s := parseOrderedSet(tc.s)
+			b := parseInterval(tc.b)
+			w := parseOrderedSet(tc.w)
+			get := slices.Collect(s.Within(b))
+			if !equalIntervals(get, w.intervals) {
+				t.Errorf("want %s.Within(%s) = %s but get %v", s, b, w, get)
+			}
+		})
+	}
+}
+
+func TestOrderedSet_Gaps(t *testing.T) {
+	var gapsCases = []struct {
+		s string
+		b string
+		w string
+	}{
+		{ // 0
+			s: "",
+			b: "===",
+			w: "===",
+		},
+		{ // 1
+			s: "===",
+			b: "",
+			w: "",
+		},
+		{ // 2
+			s: "===   ===  ===",
+			b: " ==========",
+			w: "  *===* *==",
+		},
+		{ // 3
+			s: "  ==",
+			b: "<====>",
+			w: "<=**=>",
+		},
+	}
+	for n, tc := range gapsCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			s := parseOrderedSet(tc.s)
+			b := parseInterval(tc.b)
+			w := parseOrderedSet(tc.w)
+			get := slices.Collect(s.Gaps(b))
+			if !equalIntervals(get, w.intervals) {
+				t.Errorf("want %s.Gaps(%s) = %s but get %v", s, b, w, get)
+			}
+		})
+	}
+}
+
+func TestCollect(t *testing.T) {
+	s := Collect(slices.Values([]Interval[int]{
+		parseInterval("    ==="),
+		parseInterval("=="),
+		parseInterval("  *="),
+	}))
+	w := OrderedSet[int]{intervals: []Interval[int]{
+		parseInterval("=="),
+		parseInterval("  *="),
+		parseInterval("    ==="),
+	}}
+	if !s.Equal(w) {
+		t.Errorf("want Collect() = %s but get %s", w, s)
+	}
+}
+
+func TestSeqOperations(t *testing.T) {
+	var seqCases = []struct {
+		a string
+		b string
+	}{
+		{ // 0
+			a: "",
+			b: "",
+		},
+		{ // 1
+			a: "===",
+			b: "",
+		},
+		{ // 2
+			a: "  ===  ====   =====    ======",
+			b: "==* *==*  ====*   *=====    ====",
+		},
+		{ // 3
+			a: "  ===  ====   =====    ======",
+			b: "===* ====* *=====*   *=====**====",
+		},
+		{ // 4
+			a: "<==   *=*  ==*",
+			b: "  *=*=*   *==>",
+		},
+	}
+	ops := []struct {
+		name  string
+		slice func(a, b OrderedSet[int]) OrderedSet[int]
+		seq   func(a, b OrderedSet[int]) []Interval[int]
+	}{
+		{"Union", Union[int], func(a, b OrderedSet[int]) []Interval[int] {
+			return slices.Collect(UnionSeq(a.All(), b.All()))
+		}},
+		{"Intersect", Intersect[int], func(a, b OrderedSet[int]) []Interval[int] {
+			return slices.Collect(IntersectSeq(a.All(), b.All()))
+		}},
+		{"Subtract", Subtract[int], func(a, b OrderedSet[int]) []Interval[int] {
+			return slices.Collect(SubtractSeq(a.All(), b.All()))
+		}},
+		{"Difference", Difference[int], func(a, b OrderedSet[int]) []Interval[int] {
+			return slices.Collect(DifferenceSeq(a.All(), b.All()))
+		}},
+	}
+	for n, tc := range seqCases {
+		for _, op := range ops {
+			t.Run(fmt.Sprint(n, op.name), func(t *testing.T) {
+				a := parseOrderedSet(tc.a)
+				b := parseOrderedSet(tc.b)
+				for _, ab := range [][2]OrderedSet[int]{{a, b}, {b, a}} {
+					w := op.slice(ab[0], ab[1])
+					get := op.seq(ab[0], ab[1])
+					if !equalIntervals(get, w.intervals) {
+						t.Errorf("want %sSeq(%s, %s) = %s but get %v", op.name, ab[0], ab[1], w, get)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestSeqOperations_Break(t *testing.T) {
+	a := parseOrderedSet("==  ==  ==  ==")
+	b := parseOrderedSet(" ==  ==  ==  ==")
+	var get []Interval[int]
+	for x := range UnionSeq(a.All(), b.All()) {
+		get = append(get, x)
+		if len(get) == 2 {
+			break
+		}
+	}
+	w := parseOrderedSet("=== ===").intervals
+	if !equalIntervals(get, w) {
+		t.Errorf("want %v but get %v", w, get)
+	}
+}
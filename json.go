@@ -0,0 +1,141 @@
+package interval
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// intervalObject is the object form of an interval in JSON. The begin and
+// end keys are required, a null begin or end means the interval is
+// unbounded on that side.
+type intervalObject struct {
+	Begin    json.RawMessage `json:"begin"`
+	IncBegin bool            `json:"incBegin"`
+	End      json.RawMessage `json:"end"`
+	IncEnd   bool            `json:"incEnd"`
+}
+
+// MarshalJSON implements json.Marshaler, the interval is encoded as a JSON
+// string in the form printed by String.
+func (i Interval[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, it accepts a JSON string in the
+// form printed by String, or an object in the form
+// {"begin": 0, "incBegin": true, "end": 10, "incEnd": false} where a null
+// begin or end means the interval is unbounded on that side. The begin and
+// end keys are required and unknown keys are rejected, so a misspelled key
+// is an error rather than an unbounded interval.
+func (i *Interval[T]) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return errors.New("interval: unmarshal empty JSON")
+	}
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	switch data[0] {
+	case '"':
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		x, err := ParseInterval[T](s)
+		if err != nil {
+			return err
+		}
+		*i = x
+		return nil
+	case '{':
+		var o intervalObject
+		d := json.NewDecoder(bytes.NewReader(data))
+		d.DisallowUnknownFields()
+		if err := d.Decode(&o); err != nil {
+			return fmt.Errorf("interval: unmarshal JSON %s: %w", data, err)
+		}
+		x, err := intervalOf[T](o)
+		if err != nil {
+			return fmt.Errorf("interval: unmarshal JSON %s: %w", data, err)
+		}
+		*i = x
+		return nil
+	}
+	return fmt.Errorf("interval: can not unmarshal JSON %s into Interval", data)
+}
+
+// intervalOf returns the interval of the object form o.
+func intervalOf[T cmp.Ordered](o intervalObject) (Interval[T], error) {
+	var i Interval[T]
+	var err error
+	if i.UnboundedBegin, err = decodeEndpoint("begin", o.Begin, &i.Begin); err != nil {
+		return Interval[T]{}, err
+	}
+	if i.UnboundedEnd, err = decodeEndpoint("end", o.End, &i.End); err != nil {
+		return Interval[T]{}, err
+	}
+	i.IncBegin = o.IncBegin && !i.UnboundedBegin
+	i.IncEnd = o.IncEnd && !i.UnboundedEnd
+	return i, nil
+}
+
+// decodeEndpoint decodes the endpoint raw into v, it returns true if raw is
+// null.
+func decodeEndpoint[T cmp.Ordered](name string, raw json.RawMessage, v *T) (bool, error) {
+	switch {
+	case raw == nil:
+		return false, fmt.Errorf("missing %q, use null for an unbounded %s", name, name)
+	case bytes.Equal(raw, []byte("null")):
+		return true, nil
+	}
+	return false, json.Unmarshal(raw, v)
+}
+
+// MarshalJSON implements json.Marshaler, the ordered set is encoded as a
+// JSON array of intervals.
+func (s OrderedSet[T]) MarshalJSON() ([]byte, error) {
+	intervals := s.intervals
+	if intervals == nil {
+		intervals = []Interval[T]{}
+	}
+	return json.Marshal(intervals)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, it accepts a JSON array of
+// intervals in any form accepted by Interval.UnmarshalJSON, or a JSON string
+// in the form printed by String.
+// The intervals are normalised as a batch by FromIntervals, so overlapping
+// or unordered intervals are merged.
+func (s *OrderedSet[T]) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return errors.New("interval: unmarshal empty JSON")
+	}
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	switch data[0] {
+	case '"':
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return err
+		}
+		x, err := ParseOrderedSet[T](str)
+		if err != nil {
+			return err
+		}
+		*s = x
+		return nil
+	case '[':
+		var intervals []Interval[T]
+		if err := json.Unmarshal(data, &intervals); err != nil {
+			return err
+		}
+		*s = FromIntervals(intervals)
+		return nil
+	}
+	return fmt.Errorf("interval: can not unmarshal JSON %s into OrderedSet", data)
+}
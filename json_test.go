@@ -0,0 +1,156 @@
+package interval
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestInterval_JSON(t *testing.T) {
+	var jsonCases = []struct {
+		j string
+		w string
+	}{
+		{ // 0
+			j: `"[0, 10)"`,
+			w: "==========*",
+		},
+		{ // 1
+			j: `"(-inf,3]"`,
+			w: "<===",
+		},
+		{ // 2
+			j: `{"begin": 2, "incBegin": false, "end": 5, "incEnd": true}`,
+			w: "--*===",
+		},
+		{ // 3
+			j: `{"begin": 2, "incBegin": true, "end": null}`,
+			w: "--=>",
+		},
+		{ // 4
+			j: `{"begin": null, "end": null, "incEnd": true}`,
+			w: "<>",
+		},
+	}
+	for n, tc := range jsonCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			var i Interval[int]
+			if err := json.Unmarshal([]byte(tc.j), &i); err != nil {
+				t.Fatalf("want unmarshal %s succeeds but get %v", tc.j, err)
+			}
+			w := parseInterval(tc.w)
+			if !i.Equal(w) {
+				t.Errorf("want unmarshal %s = %s but get %s", tc.j, w, i)
+			}
+			data, err := json.Marshal(i)
+			if err != nil {
+				t.Fatalf("want marshal %s succeeds but get %v", i, err)
+			}
+			var r Interval[int]
+			if err := json.Unmarshal(data, &r); err != nil || !r.Equal(i) {
+				t.Errorf("want unmarshal %s = %s but get %s, %v", data, i, r, err)
+			}
+
+			o, err := objectOf(i)
+			if err != nil {
+				t.Fatalf("want object of %s succeeds but get %v", i, err)
+			}
+			if data, err = json.Marshal(o); err != nil {
+				t.Fatalf("want marshal %s succeeds but get %v", i, err)
+			}
+			r = Interval[int]{}
+			if err := json.Unmarshal(data, &r); err != nil || r != i {
+				t.Errorf("want unmarshal object %s = %#v but get %#v, %v", data, i, r, err)
+			}
+		})
+	}
+
+	var i Interval[int]
+	for _, j := range []string{
+		`"[0, 10"`, `[0, 10]`, `{"begin": "a", "end": null}`, `12`,
+		`{}`, `{"start": 1, "stop": 5}`, `{"begin": 1, "incBegin": true}`,
+		`{"begin": 1, "end": 5, "incEnd": true, "unbounded": true}`,
+	} {
+		if err := json.Unmarshal([]byte(j), &i); err == nil {
+			t.Errorf("want unmarshal %s fails but get %s", j, i)
+		}
+	}
+}
+
+// objectOf returns the object form of i accepted by Interval.UnmarshalJSON.
+func objectOf[T cmp.Ordered](i Interval[T]) (intervalObject, error) {
+	o := intervalObject{
+		Begin:    json.RawMessage("null"),
+		IncBegin: i.IncBegin && !i.UnboundedBegin,
+		End:      json.RawMessage("null"),
+		IncEnd:   i.IncEnd && !i.UnboundedEnd,
+	}
+	var err error
+	if !i.UnboundedBegin {
+		if o.Begin, err = json.Marshal(i.Begin); err != nil {
+			return intervalObject{}, err
+		}
+	}
+	if !i.UnboundedEnd {
+		if o.End, err = json.Marshal(i.End); err != nil {
+			return intervalObject{}, err
+		}
+	}
+	return o, nil
+}
+
+func TestOrderedSet_JSON(t *testing.T) {
+	var jsonCases = []struct {
+		j string
+		w string
+	}{
+		{ // 0
+			j: `[]`,
+			w: "",
+		},
+		{ // 1
+			j: `null`,
+			w: "",
+		},
+		{ // 2
+			j: `["[0, 3)", "[5, 7]"]`,
+			w: "===* ===",
+		},
+		{ // 3
+			j: `["[5, 7]", {"begin": 0, "incBegin": true, "end": 3}, "[2, 5)"]`,
+			w: "========",
+		},
+		{ // 4
+			j: `"{(-inf, 1], [3, +inf)}"`,
+			w: "<= =>",
+		},
+	}
+	for n, tc := range jsonCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			var s OrderedSet[int]
+			if err := json.Unmarshal([]byte(tc.j), &s); err != nil {
+				t.Fatalf("want unmarshal %s succeeds but get %v", tc.j, err)
+			}
+			w := parseOrderedSet(tc.w)
+			if !s.Equal(w) {
+				t.Errorf("want unmarshal %s = %s but get %s", tc.j, w, s)
+			}
+			data, err := json.Marshal(s)
+			if err != nil {
+				t.Fatalf("want marshal %s succeeds but get %v", s, err)
+			}
+			var r OrderedSet[int]
+			if err := json.Unmarshal(data, &r); err != nil || !r.Equal(s) {
+				t.Errorf("want unmarshal %s = %s but get %s, %v", data, s, r, err)
+			}
+		})
+	}
+
+	data, _ := json.Marshal(struct {
+		S OrderedSet[int] `json:"s"`
+	}{parseOrderedSet("===* ===")})
+	if w := `{"s":["[0, 3)","[5, 7]"]}`; string(data) != w {
+		t.Errorf("want marshal = %s but get %s", w, data)
+	}
+}
@@ -0,0 +1,193 @@
+package interval
+
+import (
+	"cmp"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MapEntry is an interval and the value mapped to it.
+type MapEntry[T cmp.Ordered, V comparable] struct {
+	Interval Interval[T]
+	Value    V
+}
+
+// OrderedMap is a map from ordered and non-overlapping intervals to values.
+// Adjacent intervals mapped to equal values are merged.
+type OrderedMap[T cmp.Ordered, V comparable] struct {
+	entries []MapEntry[T, V]
+}
+
+// Copy returns a copy of a ordered map that without affecting the original.
+func (m OrderedMap[T, V]) Copy() OrderedMap[T, V] {
+	return OrderedMap[T, V]{append([]MapEntry[T, V](nil), m.entries...)}
+}
+
+// Len returns length of entries in this ordered map.
+func (m OrderedMap[T, V]) Len() int {
+	return len(m.entries)
+}
+
+// IsEmpty returns true if no entries in this ordered map.
+func (m OrderedMap[T, V]) IsEmpty() bool {
+	return len(m.entries) == 0
+}
+
+func (m OrderedMap[T, V]) Equal(x OrderedMap[T, V]) bool {
+	if len(m.entries) != len(x.entries) {
+		return false
+	}
+	for n := range m.entries {
+		if !m.entries[n].Interval.Equal(x.entries[n].Interval) ||
+			m.entries[n].Value != x.entries[n].Value {
+			return false
+		}
+	}
+	return true
+}
+
+func (m OrderedMap[T, V]) String() string {
+	var b strings.Builder
+	b.WriteByte('{')
+	for n, e := range m.entries {
+		if n > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%s: %v", e.Interval, e.Value)
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// Entries returns a copy of entries in this ordered map.
+func (m OrderedMap[T, V]) Entries() []MapEntry[T, V] {
+	return append([]MapEntry[T, V](nil), m.entries...)
+}
+
+// Domain returns an ordered set of all intervals mapped by this ordered map.
+func (m OrderedMap[T, V]) Domain() OrderedSet[T] {
+	var intervals []Interval[T]
+	for _, e := range m.entries {
+		intervals = adjoinOrAppend(intervals, e.Interval)
+	}
+	return OrderedSet[T]{intervals: intervals}
+}
+
+// searchLow returns the first index in m.entries that is not before x.
+// if not found, searchLow returns len(m.entries).
+func (m *OrderedMap[T, V]) searchLow(x Interval[T]) int {
+	return sort.Search(len(m.entries), func(i int) bool {
+		return !m.entries[i].Interval.LtBeginOf(x)
+	})
+}
+
+// searchHigh returns the index of the first entry in m.entries that is
+// entirely after x.
+// if not found, searchHigh returns len(m.entries).
+func (m *OrderedMap[T, V]) searchHigh(x Interval[T]) int {
+	return sort.Search(len(m.entries), func(i int) bool {
+		return x.LtBeginOf(m.entries[i].Interval)
+	})
+}
+
+// Get returns the value mapped to point p.
+func (m OrderedMap[T, V]) Get(p T) (V, bool) {
+	x := point(p)
+	idx := m.searchLow(x)
+	if idx < len(m.entries) && m.entries[idx].Interval.Contains(x) {
+		return m.entries[idx].Value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func adjoinOrAppendEntry[T cmp.Ordered, V comparable](entries []MapEntry[T, V], e MapEntry[T, V]) []MapEntry[T, V] {
+	n := len(entries)
+	switch n {
+	case 0:
+		return append(entries, e)
+	default:
+		n--
+		if entries[n].Value != e.Value {
+			return append(entries, e)
+		}
+		ad := entries[n].Interval.Adjoin(e.Interval)
+		if ad.IsEmpty() {
+			return append(entries, e)
+		}
+		entries[n].Interval = ad
+		return entries
+	}
+}
+
+// Set maps x interval to value v, overwriting the values of the portion
+// of existing entries covered by x.
+func (m *OrderedMap[T, V]) Set(x Interval[T], v V) {
+	m.update(x, func(V, bool) (V, bool) {
+		return v, true
+	})
+}
+
+// Delete removes the portion of entries covered by x interval from this
+// ordered map.
+// Delete returns true if this ordered map changed.
+func (m *OrderedMap[T, V]) Delete(x Interval[T]) bool {
+	if m.IsEmpty() || x.IsEmpty() {
+		return false
+	}
+	if m.searchLow(x) == m.searchHigh(x) {
+		return false
+	}
+	m.update(x, func(v V, _ bool) (V, bool) {
+		return v, false
+	})
+	return true
+}
+
+// update replaces the values of the portion of this ordered map covered by
+// x interval with the results of f, f is called with false for the gaps
+// between entries. If f returns false the portion is removed.
+func (m *OrderedMap[T, V]) update(x Interval[T], f func(v V, ok bool) (V, bool)) {
+	if x.IsEmpty() {
+		return
+	}
+
+	low, high := m.searchLow(x), m.searchHigh(x)
+	entries := make([]MapEntry[T, V], 0, len(m.entries)+high-low+2)
+	entries = append(entries, m.entries[:low]...)
+	push := func(i Interval[T], v V, ok bool) {
+		if ok && !i.IsEmpty() {
+			entries = adjoinOrAppendEntry(entries, MapEntry[T, V]{i, v})
+		}
+	}
+	var zero V
+	rest := x
+	for _, e := range m.entries[low:high] {
+		//                 (low)                 (high)
+		//       0    1      2         3           4        5    6   7
+		//      === ===== ======== =========== ========= ======= == ====
+		//              *======================*
+		//                        (x)
+		left, right := e.Interval.Bisect(x)
+		gap, after := rest.Bisect(e.Interval)
+		push(left, e.Value, true)
+		if !gap.IsEmpty() {
+			v, ok := f(zero, false)
+			push(gap, v, ok)
+		}
+		v, ok := f(e.Value, true)
+		push(e.Interval.Intersect(x), v, ok)
+		push(right, e.Value, true)
+		rest = after
+	}
+	if !rest.IsEmpty() {
+		v, ok := f(zero, false)
+		push(rest, v, ok)
+	}
+	if high < len(m.entries) {
+		push(m.entries[high].Interval, m.entries[high].Value, true)
+		entries = append(entries, m.entries[high+1:]...)
+	}
+	m.entries = entries
+}
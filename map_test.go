@@ -0,0 +1,158 @@
+package interval
+
+import (
+	"fmt"
+	"testing"
+)
+
+type testMapEntry struct {
+	i string
+	v string
+}
+
+func parseOrderedMap(entries []testMapEntry) OrderedMap[int, string] {
+	var m OrderedMap[int, string]
+	for _, e := range entries {
+		m.entries = append(m.entries, MapEntry[int, string]{parseInterval(e.i), e.v})
+	}
+	return m
+}
+
+func TestOrderedMap_Set(t *testing.T) {
+	var setCases = []struct {
+		m []testMapEntry
+		i string
+		v string
+		w []testMapEntry
+	}{
+		{ // 0
+			m: nil,
+			i: "===*",
+			v: "a",
+			w: []testMapEntry{{"===*", "a"}},
+		},
+		{ // 1
+			m: []testMapEntry{{"=====*", "a"}},
+			i: " ==*",
+			v: "b",
+			w: []testMapEntry{{"=*", "a"}, {" ==*", "b"}, {"   ==*", "a"}},
+		},
+		{ // 2
+			m: []testMapEntry{{"===*", "a"}, {"   ===*", "b"}, {"      ===", "c"}},
+			i: "  =====*",
+			v: "d",
+			w: []testMapEntry{{"==*", "a"}, {"  =====*", "d"}, {"       ==", "c"}},
+		},
+		{ // 3
+			m: []testMapEntry{{"===*", "a"}, {"      ===", "a"}},
+			i: "   ===*",
+			v: "a",
+			w: []testMapEntry{{"=========", "a"}},
+		},
+		{ // 4
+			m: []testMapEntry{{"===*", "a"}, {"   ===*", "b"}, {"      ===", "a"}},
+			i: "   ===*",
+			v: "a",
+			w: []testMapEntry{{"=========", "a"}},
+		},
+		{ // 5
+			m: []testMapEntry{{"===*", "a"}},
+			i: "<>",
+			v: "b",
+			w: []testMapEntry{{"<>", "b"}},
+		},
+		{ // 6
+			m: []testMapEntry{{"===*", "a"}},
+			i: "",
+			v: "b",
+			w: []testMapEntry{{"===*", "a"}},
+		},
+	}
+	for n, tc := range setCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			m := parseOrderedMap(tc.m)
+			om := m.Copy()
+			i := parseInterval(tc.i)
+			m.Set(i, tc.v)
+			w := parseOrderedMap(tc.w)
+			if !m.Equal(w) {
+				t.Errorf("want %s.Set(%s, %s) = %s but get %s", om, i, tc.v, w, m)
+			}
+		})
+	}
+}
+
+func TestOrderedMap_Delete(t *testing.T) {
+	var deleteCases = []struct {
+		m []testMapEntry
+		i string
+		w []testMapEntry
+		c bool
+	}{
+		{ // 0
+			m: nil,
+			i: "===*",
+			w: nil,
+			c: false,
+		},
+		{ // 1
+			m: []testMapEntry{{"===*", "a"}, {"     ===", "b"}},
+			i: "   *=*",
+			w: []testMapEntry{{"===*", "a"}, {"     ===", "b"}},
+			c: false,
+		},
+		{ // 2
+			m: []testMapEntry{{"===*", "a"}, {"   ===*", "b"}, {"      ===", "c"}},
+			i: " ======",
+			w: []testMapEntry{{"=*", "a"}, {"      *==", "c"}},
+			c: true,
+		},
+		{ // 3
+			m: []testMapEntry{{"=========", "a"}},
+			i: "   ===",
+			w: []testMapEntry{{"===*", "a"}, {"     *===", "a"}},
+			c: true,
+		},
+	}
+	for n, tc := range deleteCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			m := parseOrderedMap(tc.m)
+			om := m.Copy()
+			i := parseInterval(tc.i)
+			c := m.Delete(i)
+			if c != tc.c {
+				t.Errorf("want changed is %v but get %v", tc.c, c)
+			}
+			w := parseOrderedMap(tc.w)
+			if !m.Equal(w) {
+				t.Errorf("want %s.Delete(%s) = %s but get %s", om, i, w, m)
+			}
+		})
+	}
+}
+
+func TestOrderedMap_Get(t *testing.T) {
+	m := parseOrderedMap([]testMapEntry{{"===*", "a"}, {"   ===*", "b"}, {"        ==>", "c"}})
+	var getCases = []struct {
+		p  int
+		v  string
+		ok bool
+	}{
+		{p: -1, v: "", ok: false},
+		{p: 0, v: "a", ok: true},
+		{p: 3, v: "b", ok: true},
+		{p: 6, v: "", ok: false},
+		{p: 7, v: "", ok: false},
+		{p: 100, v: "c", ok: true},
+	}
+	for _, tc := range getCases {
+		v, ok := m.Get(tc.p)
+		if v != tc.v || ok != tc.ok {
+			t.Errorf("want %s.Get(%d) = %q, %v but get %q, %v", m, tc.p, tc.v, tc.ok, v, ok)
+		}
+	}
+	w := parseOrderedSet("======* =>")
+	if d := m.Domain(); !d.Equal(w) {
+		t.Errorf("want %s.Domain() = %s but get %s", m, w, d)
+	}
+}
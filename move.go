@@ -0,0 +1,136 @@
+package interval
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// ErrOverflow is returned when an endpoint does not fit the endpoint type.
+var ErrOverflow = errors.New("interval: overflow")
+
+// MoveChecked returns an interval that adds number x to begin and end of
+// receiver interval like Move, it returns an error wrapping ErrOverflow if
+// an endpoint overflows T. Float endpoints overflow when they become
+// infinite.
+func (i Interval[T]) MoveChecked(x T) (Interval[T], error) {
+	m, ob, oe := i.move(x)
+	if ob != 0 || oe != 0 {
+		return Interval[T]{}, fmt.Errorf("interval: move %s by %s: %w", i, formatValue(x), ErrOverflow)
+	}
+	return m, nil
+}
+
+// MoveSaturating returns an interval that adds number x to begin and end
+// of receiver interval like Move, endpoints that overflow T are clamped to
+// the minimum or maximum of T and become inclusive, so an interval moved
+// past a bound collapses to that bound. For float T the bounds are the
+// largest finite values, such as -math.MaxFloat64 and math.MaxFloat64,
+// and not the infinities.
+func (i Interval[T]) MoveSaturating(x T) Interval[T] {
+	m, ob, oe := i.move(x)
+	if ob != 0 {
+		m.IncBegin = true
+	}
+	if oe != 0 {
+		m.IncEnd = true
+	}
+	return m
+}
+
+// Move returns an ordered set that adds number x to every interval of this
+// ordered set. Intervals moved entirely past the minimum or maximum of T
+// are dropped and intervals moved partly past it are clipped to it.
+func (s OrderedSet[T]) Move(x T) OrderedSet[T] {
+	var intervals []Interval[T]
+	for _, i := range s.intervals {
+		m, ob, oe := i.move(x)
+		if ob > 0 || oe < 0 {
+			continue
+		}
+		if ob < 0 {
+			m.IncBegin = true
+		}
+		if oe > 0 {
+			m.IncEnd = true
+		}
+		if !m.IsEmpty() {
+			intervals = append(intervals, m)
+		}
+	}
+	return OrderedSet[T]{intervals: intervals}
+}
+
+// MoveChecked returns an ordered set that adds number x to every interval
+// of this ordered set, it returns an error wrapping ErrOverflow if an
+// endpoint overflows T.
+func (s OrderedSet[T]) MoveChecked(x T) (OrderedSet[T], error) {
+	intervals := make([]Interval[T], 0, len(s.intervals))
+	for _, i := range s.intervals {
+		m, err := i.MoveChecked(x)
+		if err != nil {
+			return OrderedSet[T]{}, err
+		}
+		intervals = append(intervals, m)
+	}
+	return OrderedSet[T]{intervals: intervals}, nil
+}
+
+// move returns receiver interval moved by x with saturated endpoints, and
+// 1 or -1 for the begin and the end that overflow above the maximum or
+// below the minimum of T.
+func (i Interval[T]) move(x T) (Interval[T], int, int) {
+	if i.IsEmpty() {
+		return Interval[T]{}, 0, 0
+	}
+	var ob, oe int
+	if !i.UnboundedBegin {
+		i.Begin, ob = addEndpoint(i.Begin, x)
+	}
+	if !i.UnboundedEnd {
+		i.End, oe = addEndpoint(i.End, x)
+	}
+	return i, ob, oe
+}
+
+// addEndpoint returns v + x, it returns 1 or -1 if the sum overflows above
+// the maximum or below the minimum of T, and the sum is then saturated at
+// that bound. A float sum overflows when it becomes infinite and is then
+// saturated at the largest finite value of T, strings never overflow.
+func addEndpoint[T cmp.Ordered](v, x T) (T, int) {
+	var zero T
+	sum := v + x
+	over := 0
+	rv := reflect.ValueOf(&sum).Elem()
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch {
+		case x > zero && sum < v:
+			over = 1
+			rv.SetInt(1<<(rv.Type().Bits()-1) - 1)
+		case x < zero && sum > v:
+			over = -1
+			rv.SetInt(-1 << (rv.Type().Bits() - 1))
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if sum < v {
+			over = 1
+			rv.SetUint(math.MaxUint64 >> (64 - rv.Type().Bits()))
+		}
+	case reflect.Float32, reflect.Float64:
+		if f := rv.Float(); math.IsInf(f, 0) && !math.IsInf(reflect.ValueOf(v).Float(), 0) {
+			bound := math.MaxFloat64
+			if rv.Type().Bits() == 32 {
+				bound = math.MaxFloat32
+			}
+			over = 1
+			if f < 0 {
+				over, bound = -1, -bound
+			}
+			rv.SetFloat(bound)
+		}
+	}
+	return sum, over
+}
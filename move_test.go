@@ -0,0 +1,103 @@
+package interval
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestInterval_MoveChecked(t *testing.T) {
+	i := Interval[int8]{Begin: 100, IncBegin: true, End: 120}
+	if m, err := i.MoveChecked(7); err != nil || !m.Equal(Interval[int8]{Begin: 107, IncBegin: true, End: 127}) {
+		t.Errorf("want %s.MoveChecked(7) = [107, 127) but get %s, %v", i, m, err)
+	}
+	if m, err := i.MoveChecked(8); !errors.Is(err, ErrOverflow) {
+		t.Errorf("want %s.MoveChecked(8) overflow but get %s, %v", i, m, err)
+	}
+	if m, err := i.MoveChecked(-128); err != nil || m.Begin != -28 {
+		t.Errorf("want %s.MoveChecked(-128) = [-28, -8) but get %s, %v", i, m, err)
+	}
+
+	u := Interval[uint]{Begin: 1, IncBegin: true, End: math.MaxUint - 1, UnboundedEnd: true}
+	if m, err := u.MoveChecked(math.MaxUint - 1); err != nil || !m.Equal(Interval[uint]{Begin: math.MaxUint, IncBegin: true, UnboundedEnd: true}) {
+		t.Errorf("want %s.MoveChecked(MaxUint-1) = [MaxUint, +inf) but get %s, %v", u, m, err)
+	}
+	if _, err := u.MoveChecked(math.MaxUint); !errors.Is(err, ErrOverflow) {
+		t.Errorf("want %s.MoveChecked(MaxUint) overflow but get %v", u, err)
+	}
+
+	f := Interval[float64]{Begin: 0, IncBegin: true, End: math.MaxFloat64}
+	if _, err := f.MoveChecked(math.MaxFloat64); !errors.Is(err, ErrOverflow) {
+		t.Errorf("want %s.MoveChecked(MaxFloat64) overflow but get %v", f, err)
+	}
+
+	s := Interval[string]{Begin: "a", IncBegin: true, End: "b"}
+	if m, err := s.MoveChecked("x"); err != nil || m.Begin != "ax" || m.End != "bx" {
+		t.Errorf("want %s.MoveChecked(\"x\") = [\"ax\", \"bx\") but get %s, %v", s, m, err)
+	}
+}
+
+func TestInterval_MoveSaturating(t *testing.T) {
+	i := Interval[int8]{Begin: 100, IncBegin: true, End: 120}
+	if m, w := i.MoveSaturating(10), (Interval[int8]{Begin: 110, IncBegin: true, End: 127, IncEnd: true}); !m.Equal(w) {
+		t.Errorf("want %s.MoveSaturating(10) = %s but get %s", i, w, m)
+	}
+	if m, w := i.MoveSaturating(100), (Interval[int8]{Begin: 127, IncBegin: true, End: 127, IncEnd: true}); !m.Equal(w) {
+		t.Errorf("want %s.MoveSaturating(100) = %s but get %s", i, w, m)
+	}
+	if m, w := i.MoveSaturating(-128), (Interval[int8]{Begin: -28, IncBegin: true, End: -8}); !m.Equal(w) {
+		t.Errorf("want %s.MoveSaturating(-128) = %s but get %s", i, w, m)
+	}
+	j := Interval[int8]{Begin: -100, End: -90, IncEnd: true}
+	if m, w := j.MoveSaturating(-30), (Interval[int8]{Begin: -128, IncBegin: true, End: -120, IncEnd: true}); !m.Equal(w) {
+		t.Errorf("want %s.MoveSaturating(-30) = %s but get %s", j, w, m)
+	}
+
+	f := Interval[float64]{Begin: 1e308, IncBegin: true, End: 1.5e308}
+	if m, w := f.MoveSaturating(1e308), (Interval[float64]{Begin: math.MaxFloat64, IncBegin: true, End: math.MaxFloat64, IncEnd: true}); !m.Equal(w) {
+		t.Errorf("want %s.MoveSaturating(1e308) = %s but get %s", f, w, m)
+	}
+	g := Interval[float32]{Begin: -3e38, End: -1e38, IncEnd: true}
+	if m, w := g.MoveSaturating(-1e38), (Interval[float32]{Begin: -math.MaxFloat32, IncBegin: true, End: -2e38, IncEnd: true}); !m.Equal(w) {
+		t.Errorf("want %s.MoveSaturating(-1e38) = %s but get %s", g, w, m)
+	}
+}
+
+func TestOrderedSet_Move(t *testing.T) {
+	s := NewOrderedSet(
+		Interval[int8]{Begin: -128, IncBegin: true, End: -120},
+		Interval[int8]{Begin: 0, IncBegin: true, End: 10},
+		Interval[int8]{Begin: 100, End: 120, IncEnd: true},
+	)
+	w := NewOrderedSet(
+		Interval[int8]{Begin: -118, IncBegin: true, End: -110},
+		Interval[int8]{Begin: 10, IncBegin: true, End: 20},
+		Interval[int8]{Begin: 110, End: 127, IncEnd: true},
+	)
+	if m := s.Move(10); !m.Equal(w) {
+		t.Errorf("want %s.Move(10) = %s but get %s", s, w, m)
+	}
+	if _, err := s.MoveChecked(10); !errors.Is(err, ErrOverflow) {
+		t.Errorf("want %s.MoveChecked(10) overflow but get %v", s, err)
+	}
+
+	w = NewOrderedSet(
+		Interval[int8]{Begin: -115, IncBegin: true, End: -105},
+		Interval[int8]{Begin: -15, End: 5, IncEnd: true},
+	)
+	if m := s.Move(-115); !m.Equal(w) {
+		t.Errorf("want %s.Move(-115) = %s but get %s", s, w, m)
+	}
+
+	w = NewOrderedSet(
+		Interval[int8]{Begin: -128, IncBegin: true, End: -125},
+		Interval[int8]{Begin: -5, IncBegin: true, End: 5},
+		Interval[int8]{Begin: 95, End: 115, IncEnd: true},
+	)
+	if m := s.Move(-5); !m.Equal(w) {
+		t.Errorf("want %s.Move(-5) = %s but get %s", s, w, m)
+	}
+	if m, err := s.MoveChecked(0); err != nil || !m.Equal(s) {
+		t.Errorf("want %s.MoveChecked(0) = %s but get %s, %v", s, s, m, err)
+	}
+}
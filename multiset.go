@@ -0,0 +1,96 @@
+package interval
+
+import (
+	"cmp"
+)
+
+// Multiset is a set of intervals that counts how many times each point is
+// covered, unlike OrderedSet adding an interval twice covers its points
+// twice.
+type Multiset[T cmp.Ordered] struct {
+	depths OrderedMap[T, int]
+}
+
+// Copy returns a copy of a multiset that without affecting the original.
+func (s Multiset[T]) Copy() Multiset[T] {
+	return Multiset[T]{s.depths.Copy()}
+}
+
+// IsEmpty returns true if no points are covered by this multiset.
+func (s Multiset[T]) IsEmpty() bool {
+	return s.depths.IsEmpty()
+}
+
+func (s Multiset[T]) Equal(x Multiset[T]) bool {
+	return s.depths.Equal(x.depths)
+}
+
+func (s Multiset[T]) String() string {
+	return s.depths.String()
+}
+
+// Depths returns an ordered map from intervals to the number of times
+// their points are covered.
+func (s Multiset[T]) Depths() OrderedMap[T, int] {
+	return s.depths.Copy()
+}
+
+// Depth returns the number of times point p is covered.
+func (s Multiset[T]) Depth(p T) int {
+	d, _ := s.depths.Get(p)
+	return d
+}
+
+// Add increments the depth of points in x interval.
+// Add returns true if this multiset changed.
+func (s *Multiset[T]) Add(x Interval[T]) bool {
+	if x.IsEmpty() {
+		return false
+	}
+	s.depths.update(x, func(d int, _ bool) (int, bool) {
+		return d + 1, true
+	})
+	return true
+}
+
+// Remove decrements the depth of points in x interval, points that are not
+// covered are left as is.
+// Remove returns true if this multiset changed.
+func (s *Multiset[T]) Remove(x Interval[T]) bool {
+	if x.IsEmpty() || s.depths.searchLow(x) == s.depths.searchHigh(x) {
+		return false
+	}
+	s.depths.update(x, func(d int, ok bool) (int, bool) {
+		return d - 1, ok && d > 1
+	})
+	return true
+}
+
+// AtLeast returns an ordered set of points covered at least k times.
+// Like the package function AtLeast, it returns (-inf, +inf) if k <= 0,
+// since every point is covered at least 0 times.
+func (s Multiset[T]) AtLeast(k int) OrderedSet[T] {
+	if k <= 0 {
+		return OrderedSet[T]{intervals: []Interval[T]{{UnboundedBegin: true, UnboundedEnd: true}}}
+	}
+	var intervals []Interval[T]
+	for _, e := range s.depths.entries {
+		if e.Value >= k {
+			intervals = adjoinOrAppend(intervals, e.Interval)
+		}
+	}
+	return OrderedSet[T]{intervals: intervals}
+}
+
+// MaxDepth returns the maximum depth of this multiset and an ordered set
+// of points covered that many times.
+func (s Multiset[T]) MaxDepth() (int, OrderedSet[T]) {
+	var depth int
+	for _, e := range s.depths.entries {
+		depth = max(depth, e.Value)
+	}
+	if depth == 0 {
+		return 0, OrderedSet[T]{}
+	}
+	return depth, s.AtLeast(depth)
+}
@@ -0,0 +1,96 @@
+package interval
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMultiset(t *testing.T) {
+	var multisetCases = []struct {
+		a []string
+		r []string
+
+		// points covered at least once and twice.
+		w1, w2 string
+		// max depth and points where it occurs.
+		d  int
+		wd string
+	}{
+		{ // 0
+			a:  nil,
+			w1: "",
+			w2: "",
+			d:  0,
+			wd: "",
+		},
+		{ // 1
+			a:  []string{"=====", "  =====", "    ==="},
+			w1: "=======",
+			w2: "  =====",
+			d:  3,
+			wd: "    =",
+		},
+		{ // 2
+			a:  []string{"=====", "  =====", "    ==="},
+			r:  []string{"    =", "=========="},
+			w1: "  =====",
+			w2: "",
+			d:  1,
+			wd: "  =====",
+		},
+		{ // 3
+			a:  []string{"===*", "   ===*", "<>"},
+			r:  []string{"<>"},
+			w1: "======*",
+			w2: "",
+			d:  1,
+			wd: "======*",
+		},
+		{ // 4
+			a:  []string{"<=====", "   ==>"},
+			w1: "<>",
+			w2: "   ===",
+			d:  2,
+			wd: "   ===",
+		},
+	}
+	for n, tc := range multisetCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			var s Multiset[int]
+			for _, a := range tc.a {
+				s.Add(parseInterval(a))
+			}
+			for _, r := range tc.r {
+				s.Remove(parseInterval(r))
+			}
+			w1, w2, wd := parseOrderedSet(tc.w1), parseOrderedSet(tc.w2), parseOrderedSet(tc.wd)
+			if a := s.AtLeast(1); !a.Equal(w1) {
+				t.Errorf("want %s.AtLeast(1) = %s but get %s", s, w1, a)
+			}
+			if a := s.AtLeast(2); !a.Equal(w2) {
+				t.Errorf("want %s.AtLeast(2) = %s but get %s", s, w2, a)
+			}
+			// every point is covered at least 0 times, as with the package
+			// function AtLeast.
+			if a, w := s.AtLeast(0), AtLeast(0, OrderedSet[int]{}); !a.Equal(w) || !a.Equal(parseOrderedSet("<>")) {
+				t.Errorf("want %s.AtLeast(0) = %s but get %s", s, w, a)
+			}
+			if d, m := s.MaxDepth(); d != tc.d || !m.Equal(wd) {
+				t.Errorf("want %s.MaxDepth() = %d, %s but get %d, %s", s, tc.d, wd, d, m)
+			}
+		})
+	}
+
+	var s Multiset[int]
+	s.Add(parseInterval("=====*"))
+	s.Add(parseInterval("  ===*"))
+	if d := s.Depth(3); d != 2 {
+		t.Errorf("want %s.Depth(3) = 2 but get %d", s, d)
+	}
+	if d := s.Depth(5); d != 0 {
+		t.Errorf("want %s.Depth(5) = 0 but get %d", s, d)
+	}
+	if s.Remove(parseInterval("       ==")) {
+		t.Errorf("want %s.Remove of uncovered interval = false", s)
+	}
+}
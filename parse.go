@@ -0,0 +1,263 @@
+package interval
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ParseError records a failed parse of an interval or an ordered set.
+type ParseError struct {
+	// Input is the text being parsed.
+	Input string
+	// Offset is the byte offset in Input where the error occurred.
+	Offset int
+	// Msg describes the error.
+	Msg string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("interval: parse %q: offset %d: %s", e.Input, e.Offset, e.Msg)
+}
+
+// ParseInterval parses an interval in the form printed by Interval.String,
+// such as "[0, 10)", "(-inf, 5]" or "[10, +inf)".
+func ParseInterval[T cmp.Ordered](s string) (Interval[T], error) {
+	p := parser{s: s}
+	p.skipSpace()
+	i, err := scanInterval[T](&p)
+	if err != nil {
+		return Interval[T]{}, err
+	}
+	p.skipSpace()
+	if !p.eof() {
+		return Interval[T]{}, p.errorf("unexpected %q after interval", p.s[p.pos])
+	}
+	return i, nil
+}
+
+// ParseOrderedSet parses an ordered set in the form printed by
+// OrderedSet.String, such as "{[-10, -5), [0, 10)}".
+// The parsed intervals are normalised as a batch by FromIntervals, so
+// overlapping or unordered intervals are merged.
+func ParseOrderedSet[T cmp.Ordered](s string) (OrderedSet[T], error) {
+	p := parser{s: s}
+	p.skipSpace()
+	set, err := scanOrderedSet[T](&p)
+	if err != nil {
+		return OrderedSet[T]{}, err
+	}
+	p.skipSpace()
+	if !p.eof() {
+		return OrderedSet[T]{}, p.errorf("unexpected %q after ordered set", p.s[p.pos])
+	}
+	return set, nil
+}
+
+func scanOrderedSet[T cmp.Ordered](p *parser) (OrderedSet[T], error) {
+	var s OrderedSet[T]
+	if err := p.expect('{'); err != nil {
+		return s, err
+	}
+	p.skipSpace()
+	if p.accept('}') {
+		return s, nil
+	}
+	var intervals []Interval[T]
+	for {
+		i, err := scanInterval[T](p)
+		if err != nil {
+			return OrderedSet[T]{}, err
+		}
+		intervals = append(intervals, i)
+		p.skipSpace()
+		if p.accept('}') {
+			return FromIntervals(intervals), nil
+		}
+		if err := p.expect(','); err != nil {
+			return OrderedSet[T]{}, err
+		}
+		p.skipSpace()
+	}
+}
+
+func scanInterval[T cmp.Ordered](p *parser) (Interval[T], error) {
+	var i Interval[T]
+	switch {
+	case p.accept('['):
+		i.IncBegin = true
+	case p.accept('('):
+	default:
+		return i, p.errorf("expected '[' or '('")
+	}
+
+	p.skipSpace()
+	off := p.pos
+	tok, err := p.token()
+	if err != nil {
+		return i, err
+	}
+	switch tok {
+	case "-inf":
+		i.IncBegin = false
+		i.UnboundedBegin = true
+	case "+inf":
+		return i, p.errorAt(off, "begin of interval can not be +inf")
+	default:
+		if i.Begin, err = parseValue[T](tok); err != nil {
+			return i, p.errorAt(off, err.Error())
+		}
+	}
+
+	p.skipSpace()
+	if err := p.expect(','); err != nil {
+		return i, err
+	}
+	p.skipSpace()
+
+	off = p.pos
+	if tok, err = p.token(); err != nil {
+		return i, err
+	}
+	switch tok {
+	case "+inf":
+		i.UnboundedEnd = true
+	case "-inf":
+		return i, p.errorAt(off, "end of interval can not be -inf")
+	default:
+		if i.End, err = parseValue[T](tok); err != nil {
+			return i, p.errorAt(off, err.Error())
+		}
+	}
+
+	p.skipSpace()
+	switch {
+	case p.accept(']'):
+		i.IncEnd = !i.UnboundedEnd
+	case p.accept(')'):
+	default:
+		return i, p.errorf("expected ']' or ')'")
+	}
+	return i, nil
+}
+
+// parser is a cursor over the text being parsed.
+type parser struct {
+	s   string
+	pos int
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.s)
+}
+
+func (p *parser) skipSpace() {
+	for !p.eof() && strings.IndexByte(" \t\r\n", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+func (p *parser) accept(c byte) bool {
+	if !p.eof() && p.s[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(c byte) error {
+	if !p.accept(c) {
+		return p.errorf("expected %q", c)
+	}
+	return nil
+}
+
+// token returns a quoted string or the text up to the next delimiter.
+func (p *parser) token() (string, error) {
+	if !p.eof() && p.s[p.pos] == '"' {
+		tok, err := strconv.QuotedPrefix(p.s[p.pos:])
+		if err != nil {
+			return "", p.errorf("invalid quoted string")
+		}
+		p.pos += len(tok)
+		return tok, nil
+	}
+	begin := p.pos
+	for !p.eof() && strings.IndexByte(" \t\r\n,[]()", p.s[p.pos]) < 0 {
+		p.pos++
+	}
+	if begin == p.pos {
+		return "", p.errorf("expected endpoint")
+	}
+	return p.s[begin:p.pos], nil
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	if p.eof() {
+		return p.errorAt(p.pos, "unexpected end of input, "+fmt.Sprintf(format, args...))
+	}
+	return p.errorAt(p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) errorAt(off int, msg string) error {
+	return &ParseError{Input: p.s, Offset: off, Msg: msg}
+}
+
+// formatValue formats an endpoint, strings are quoted so that they can be
+// parsed back by parseValue.
+func formatValue[T cmp.Ordered](v T) string {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, rv.Type().Bits())
+	case reflect.String:
+		return strconv.Quote(rv.String())
+	}
+	return fmt.Sprint(v)
+}
+
+// parseValue parses an endpoint formatted by formatValue.
+func parseValue[T cmp.Ordered](s string) (T, error) {
+	var v T
+	rv := reflect.ValueOf(&v).Elem()
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, rv.Type().Bits())
+		if err != nil {
+			return v, endpointError(s, err)
+		}
+		rv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, rv.Type().Bits())
+		if err != nil {
+			return v, endpointError(s, err)
+		}
+		rv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, rv.Type().Bits())
+		if err != nil {
+			return v, endpointError(s, err)
+		}
+		rv.SetFloat(f)
+	case reflect.String:
+		str, err := strconv.Unquote(s)
+		if err != nil {
+			return v, endpointError(s, err)
+		}
+		rv.SetString(str)
+	}
+	return v, nil
+}
+
+func endpointError(s string, err error) error {
+	if ne, ok := err.(*strconv.NumError); ok {
+		err = ne.Err
+	}
+	return fmt.Errorf("invalid endpoint %s: %w", s, err)
+}
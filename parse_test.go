@@ -0,0 +1,165 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestParseInterval(t *testing.T) {
+	var parseCases = []struct {
+		s string
+		w string
+		o int
+	}{
+		{ // 0
+			s: "[0, 10)",
+			w: "==========*",
+		},
+		{ // 1
+			s: "  ( 2 ,5 ] ",
+			w: "--*===",
+		},
+		{ // 2
+			s: "(-inf, 5]",
+			w: "<=====",
+		},
+		{ // 3
+			s: "[3, +inf)",
+			w: "---=>",
+		},
+		{ // 4
+			s: "(-inf, +inf)",
+			w: "<>",
+		},
+		{ // 5
+			s: "[0, 10",
+			o: 6,
+		},
+		{ // 6
+			s: "0, 10)",
+			o: 0,
+		},
+		{ // 7
+			s: "[0 10)",
+			o: 3,
+		},
+		{ // 8
+			s: "[x, 10)",
+			o: 1,
+		},
+		{ // 9
+			s: "[+inf, 10)",
+			o: 1,
+		},
+		{ // 10
+			s: "[0, 10) x",
+			o: 8,
+		},
+	}
+	for n, tc := range parseCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			i, err := ParseInterval[int](tc.s)
+			if tc.w == "" {
+				var pe *ParseError
+				if !errors.As(err, &pe) {
+					t.Fatalf("want ParseInterval(%q) fails but get %s, %v", tc.s, i, err)
+				}
+				if pe.Offset != tc.o {
+					t.Errorf("want ParseInterval(%q) fails at %d but get %v", tc.s, tc.o, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("want ParseInterval(%q) succeeds but get %v", tc.s, err)
+			}
+			w := parseInterval(tc.w)
+			if !i.Equal(w) {
+				t.Errorf("want ParseInterval(%q) = %s but get %s", tc.s, w, i)
+			}
+			r, err := ParseInterval[int](i.String())
+			if err != nil || !r.Equal(i) {
+				t.Errorf("want ParseInterval(%q) = %s but get %s, %v", i.String(), i, r, err)
+			}
+		})
+	}
+}
+
+func TestParseOrderedSet(t *testing.T) {
+	var parseCases = []struct {
+		s string
+		w string
+		o int
+	}{
+		{ // 0
+			s: "{}",
+			w: "",
+		},
+		{ // 1
+			s: "{[0, 3), [5, 7]}",
+			w: "===* ===",
+		},
+		{ // 2
+			s: " { [5, 7] ,[0, 3), (2, 4) } ",
+			w: "====*===",
+		},
+		{ // 3
+			s: "{(-inf, 1], [3, +inf)}",
+			w: "<= =>",
+		},
+		{ // 4
+			s: "{[0, 3) [5, 7]}",
+			o: 8,
+		},
+		{ // 5
+			s: "{[0, 3),",
+			o: 8,
+		},
+		{ // 6
+			s: "[0, 3)",
+			o: 0,
+		},
+	}
+	for n, tc := range parseCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			s, err := ParseOrderedSet[int](tc.s)
+			if tc.o != 0 || (tc.w == "" && tc.s != "{}") {
+				var pe *ParseError
+				if !errors.As(err, &pe) {
+					t.Fatalf("want ParseOrderedSet(%q) fails but get %s, %v", tc.s, s, err)
+				}
+				if pe.Offset != tc.o {
+					t.Errorf("want ParseOrderedSet(%q) fails at %d but get %v", tc.s, tc.o, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("want ParseOrderedSet(%q) succeeds but get %v", tc.s, err)
+			}
+			w := parseOrderedSet(tc.w)
+			if !s.Equal(w) {
+				t.Errorf("want ParseOrderedSet(%q) = %s but get %s", tc.s, w, s)
+			}
+			r, err := ParseOrderedSet[int](s.String())
+			if err != nil || !r.Equal(s) {
+				t.Errorf("want ParseOrderedSet(%q) = %s but get %s, %v", s.String(), s, r, err)
+			}
+		})
+	}
+}
+
+func TestParseOrderedSet_Ordered(t *testing.T) {
+	var f OrderedSet[float64]
+	f.Add(Interval[float64]{Begin: -1.5, IncBegin: true, End: 0.25})
+	f.Add(Interval[float64]{Begin: 3, End: 4, IncEnd: true})
+	if r, err := ParseOrderedSet[float64](f.String()); err != nil || !r.Equal(f) {
+		t.Errorf("want ParseOrderedSet(%q) = %s but get %s, %v", f.String(), f, r, err)
+	}
+
+	var s OrderedSet[string]
+	s.Add(Interval[string]{Begin: "a, b", IncBegin: true, End: "c)"})
+	s.Add(Interval[string]{Begin: "x", IncBegin: true, UnboundedEnd: true})
+	if r, err := ParseOrderedSet[string](s.String()); err != nil || !r.Equal(s) {
+		t.Errorf("want ParseOrderedSet(%q) = %s but get %s, %v", s.String(), s, r, err)
+	}
+}
@@ -0,0 +1,144 @@
+package interval
+
+import "strconv"
+
+// SetRelation is the relation between two ordered sets reported by
+// OrderedSet.Compare.
+type SetRelation int
+
+const (
+	// SetDisjoint means the sets have no point in common.
+	SetDisjoint SetRelation = iota
+	// SetOverlapping means the sets have points in common, but each of them
+	// has points the other does not have.
+	SetOverlapping
+	// SetSubset means the receiver set is a proper subset of the other.
+	SetSubset
+	// SetSuperset means the receiver set is a proper superset of the other.
+	SetSuperset
+	// SetEqual means the sets are equal.
+	SetEqual
+)
+
+func (r SetRelation) String() string {
+	switch r {
+	case SetDisjoint:
+		return "disjoint"
+	case SetOverlapping:
+		return "overlapping"
+	case SetSubset:
+		return "subset"
+	case SetSuperset:
+		return "superset"
+	case SetEqual:
+		return "equal"
+	}
+	return "SetRelation(" + strconv.Itoa(int(r)) + ")"
+}
+
+// IsSubsetOf returns true if every interval of this ordered set is covered
+// by x ordered set.
+func (s OrderedSet[T]) IsSubsetOf(x OrderedSet[T]) bool {
+	j := 0
+	for _, i := range s.intervals {
+		for j < len(x.intervals) && x.intervals[j].LtBeginOf(i) {
+			j++
+		}
+		if j == len(x.intervals) || !x.intervals[j].Contains(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSupersetOf returns true if this ordered set covers every interval of x
+// ordered set.
+func (s OrderedSet[T]) IsSupersetOf(x OrderedSet[T]) bool {
+	return x.IsSubsetOf(s)
+}
+
+// Overlaps returns true if this ordered set and x ordered set have at least
+// one point in common.
+func (s OrderedSet[T]) Overlaps(x OrderedSet[T]) bool {
+	i, j := 0, 0
+	for i < len(s.intervals) && j < len(x.intervals) {
+		if s.intervals[i].LtBeginOf(x.intervals[j]) {
+			i++
+		} else if x.intervals[j].LtBeginOf(s.intervals[i]) {
+			j++
+		} else {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDisjoint returns true if this ordered set and x ordered set have no
+// point in common.
+func (s OrderedSet[T]) IsDisjoint(x OrderedSet[T]) bool {
+	return !s.Overlaps(x)
+}
+
+// Compare returns the relation of this ordered set to x ordered set.
+// The empty set is a subset of any non-empty set.
+//
+// Compare walks both sets once, it stops as soon as each set is known to
+// have points outside the other and a common point is found.
+func (s OrderedSet[T]) Compare(x OrderedSet[T]) SetRelation {
+	// sOut and xOut tell whether s has points outside x and x has points
+	// outside s, overlap tells whether they have a common point.
+	sOut, xOut, overlap := false, false, false
+	i, j := 0, 0
+	for i < len(s.intervals) && j < len(x.intervals) && !(sOut && xOut && overlap) {
+		a, b := s.intervals[i], x.intervals[j]
+		if a.LtBeginOf(b) {
+			sOut = true
+			i++
+			continue
+		}
+		if b.LtBeginOf(a) {
+			xOut = true
+			j++
+			continue
+		}
+		overlap = true
+		// the intervals of a set are separated by gaps, so the part of an
+		// interval before the other begins or after the other ends is
+		// outside the other set.
+		switch c := compareBegin(a, b); {
+		case c < 0:
+			sOut = true
+		case c > 0:
+			xOut = true
+		}
+		switch c := compareEnd(a, b); {
+		case c < 0:
+			xOut = true
+			i++
+		case c > 0:
+			sOut = true
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+	if i < len(s.intervals) {
+		sOut = true
+	}
+	if j < len(x.intervals) {
+		xOut = true
+	}
+
+	switch {
+	case !sOut && !xOut:
+		return SetEqual
+	case !sOut:
+		return SetSubset
+	case !xOut:
+		return SetSuperset
+	case overlap:
+		return SetOverlapping
+	}
+	return SetDisjoint
+}
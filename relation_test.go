@@ -0,0 +1,139 @@
+package interval
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestOrderedSet_Compare(t *testing.T) {
+	var compareCases = []struct {
+		a string
+		b string
+		w SetRelation
+	}{
+		{ // 0
+			a: "",
+			b: "",
+			w: SetEqual,
+		},
+		{ // 1
+			a: "",
+			b: "===",
+			w: SetSubset,
+		},
+		{ // 2
+			a: "===  ===",
+			b: "===  ===",
+			w: SetEqual,
+		},
+		{ // 3
+			a: " =    =",
+			b: "===  ===",
+			w: SetSubset,
+		},
+		{ // 4
+			a: "<=========>",
+			b: "===  ===",
+			w: SetSuperset,
+		},
+		{ // 5
+			a: "==*  ===",
+			b: "  =     ==",
+			w: SetDisjoint,
+		},
+		{ // 6
+			a: "===  ===",
+			b: "  =     ==",
+			w: SetOverlapping,
+		},
+		{ // 7
+			a: "===  *==",
+			b: "=======*",
+			w: SetOverlapping,
+		},
+		{ // 8
+			a: "=e=",
+			b: "===",
+			w: SetSubset,
+		},
+	}
+	for n, tc := range compareCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			a := parseOrderedSet(tc.a)
+			b := parseOrderedSet(tc.b)
+			if r := a.Compare(b); r != tc.w {
+				t.Errorf("want %s.Compare(%s) = %s but get %s", a, b, tc.w, r)
+			}
+			sub := tc.w == SetSubset || tc.w == SetEqual
+			sup := tc.w == SetSuperset || tc.w == SetEqual
+			if a.IsSubsetOf(b) != sub || b.IsSupersetOf(a) != sub {
+				t.Errorf("want %s.IsSubsetOf(%s) = %t", a, b, sub)
+			}
+			if a.IsSupersetOf(b) != sup || b.IsSubsetOf(a) != sup {
+				t.Errorf("want %s.IsSupersetOf(%s) = %t", a, b, sup)
+			}
+			overlaps := !Intersect(a, b).IsEmpty()
+			if a.Overlaps(b) != overlaps || b.Overlaps(a) != overlaps || a.IsDisjoint(b) == overlaps {
+				t.Errorf("want %s.Overlaps(%s) = %t", a, b, overlaps)
+			}
+		})
+	}
+}
+
+// TestOrderedSet_Compare_Random compares Compare with the relation derived
+// from Subtract and Intersect.
+func TestOrderedSet_Compare_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randSet := func() OrderedSet[int] {
+		var s OrderedSet[int]
+		for n := r.Intn(5); n > 0; n-- {
+			b := r.Intn(20)
+			x := Interval[int]{Begin: b, IncBegin: r.Intn(2) == 0, End: b + r.Intn(6), IncEnd: r.Intn(2) == 0}
+			x.UnboundedBegin = r.Intn(20) == 0
+			x.UnboundedEnd = r.Intn(20) == 0
+			s.Add(x)
+		}
+		return s
+	}
+	for n := 0; n < 2000; n++ {
+		a, b := randSet(), randSet()
+		aOut, bOut := !Subtract(a, b).IsEmpty(), !Subtract(b, a).IsEmpty()
+		w := SetDisjoint
+		switch {
+		case !aOut && !bOut:
+			w = SetEqual
+		case !aOut:
+			w = SetSubset
+		case !bOut:
+			w = SetSuperset
+		case !Intersect(a, b).IsEmpty():
+			w = SetOverlapping
+		}
+		if r := a.Compare(b); r != w {
+			t.Fatalf("want %s.Compare(%s) = %s but get %s", a, b, w, r)
+		}
+	}
+}
+
+func TestOrderedSet_Compare_Allocs(t *testing.T) {
+	a := parseOrderedSet("===  ===   ====")
+	b := parseOrderedSet(" =    =    ==")
+	allocs := testing.AllocsPerRun(100, func() {
+		a.Compare(b)
+		a.Overlaps(b)
+		b.IsSubsetOf(a)
+	})
+	if allocs != 0 {
+		t.Errorf("want no allocations but get %v", allocs)
+	}
+}
+
+func TestSetRelation_String(t *testing.T) {
+	if s := SetSuperset.String(); s != "superset" {
+		t.Errorf("want superset but get %s", s)
+	}
+	if s := SetRelation(9).String(); s != "SetRelation(9)" {
+		t.Errorf("want SetRelation(9) but get %s", s)
+	}
+}
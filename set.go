@@ -1,35 +1,69 @@
 package interval
 
 import (
+	"cmp"
+	"slices"
 	"sort"
 	"strings"
 )
 
 // OrderedSet is a set of ordered and non-overlapping interval objects.
-type OrderedSet struct {
-	intervals []Interval
+type OrderedSet[T cmp.Ordered] struct {
+	intervals []Interval[T]
+}
+
+// IntOrderedSet is an ordered set of intervals with int endpoints.
+type IntOrderedSet = OrderedSet[int]
+
+// NewOrderedSet returns an ordered set containing all of intervals.
+func NewOrderedSet[T cmp.Ordered](intervals ...Interval[T]) OrderedSet[T] {
+	return FromIntervals(intervals)
+}
+
+// FromIntervals returns an ordered set containing all of intervals, which
+// may be unsorted and overlapping. It runs in O(n log n) and does not
+// modify intervals.
+func FromIntervals[T cmp.Ordered](intervals []Interval[T]) OrderedSet[T] {
+	return OrderedSet[T]{intervals: normalize(intervals)}
+}
+
+// normalize returns a sorted copy of intervals with empty intervals removed
+// and overlapping or adjacent intervals merged.
+func normalize[T cmp.Ordered](intervals []Interval[T]) []Interval[T] {
+	sorted := make([]Interval[T], 0, len(intervals))
+	for _, x := range intervals {
+		if !x.IsEmpty() {
+			sorted = append(sorted, x)
+		}
+	}
+	slices.SortFunc(sorted, compareBegin[T])
+	merged := sorted[:0]
+	for _, x := range sorted {
+		merged = mergeOrAppend(merged, x)
+	}
+	return merged
 }
 
 // Copy returns a copy of a ordered set that without affecting the original.
-func (s OrderedSet) Copy() OrderedSet {
-	return OrderedSet{append([]Interval(nil), s.intervals...)}
+func (s OrderedSet[T]) Copy() OrderedSet[T] {
+	return OrderedSet[T]{append([]Interval[T](nil), s.intervals...)}
 }
 
 // Len returns length of intervals in this ordered set.
-func (s OrderedSet) Len() int {
+func (s OrderedSet[T]) Len() int {
 	return len(s.intervals)
 }
 
 // IsEmpty returns true if no intervals in this ordered set.
-func (s OrderedSet) IsEmpty() bool {
+func (s OrderedSet[T]) IsEmpty() bool {
 	return len(s.intervals) == 0
 }
 
-func (s OrderedSet) Equal(x OrderedSet) bool {
+func (s OrderedSet[T]) Equal(x OrderedSet[T]) bool {
 	return equalIntervals(s.intervals, x.intervals)
 }
 
-func equalIntervals(s1, s2 []Interval) bool {
+func equalIntervals[T cmp.Ordered](s1, s2 []Interval[T]) bool {
 	if len(s1) != len(s2) {
 		return false
 	}
@@ -41,7 +75,7 @@ func equalIntervals(s1, s2 []Interval) bool {
 	return true
 }
 
-func (s OrderedSet) String() string {
+func (s OrderedSet[T]) String() string {
 	n := len(s.intervals)
 	switch n {
 	case 0:
@@ -60,11 +94,11 @@ func (s OrderedSet) String() string {
 }
 
 // Bound returns the Interval defined by the minimum and maximum values of this ordered set.
-func (s OrderedSet) Bound() Interval {
+func (s OrderedSet[T]) Bound() Interval[T] {
 	n := len(s.intervals)
 	switch n {
 	case 0:
-		return Interval{}
+		return Interval[T]{}
 	case 1:
 		return s.intervals[0]
 	default:
@@ -80,7 +114,7 @@ func (s OrderedSet) Bound() Interval {
 //
 // searchLow returns the first index in s.intervals that is not before x.
 // if not found, searchLow returns len(s.intervals).
-func (s *OrderedSet) searchLow(x Interval) int {
+func (s *OrderedSet[T]) searchLow(x Interval[T]) int {
 	return sort.Search(len(s.intervals), func(i int) bool {
 		return !s.intervals[i].LtBeginOf(x)
 	})
@@ -89,14 +123,14 @@ func (s *OrderedSet) searchLow(x Interval) int {
 // searchHigh returns the index of the first interval in s.intervals that is
 // entirely after x.
 // if not found, searchHigh returns len(s.intervals).
-func (s *OrderedSet) searchHigh(x Interval) int {
+func (s *OrderedSet[T]) searchHigh(x Interval[T]) int {
 	return sort.Search(len(s.intervals), func(i int) bool {
 		return x.LtBeginOf(s.intervals[i])
 	})
 }
 
 // Contains returns true if x interval is completely covered by this ordered set.
-func (s OrderedSet) Contains(x Interval) bool {
+func (s OrderedSet[T]) Contains(x Interval[T]) bool {
 	idx := s.searchLow(x)
 	if idx == len(s.intervals) {
 		return false
@@ -104,18 +138,68 @@ func (s OrderedSet) Contains(x Interval) bool {
 	return s.intervals[idx].Contains(x)
 }
 
+// point returns the interval [p, p].
+func point[T cmp.Ordered](p T) Interval[T] {
+	return Interval[T]{Begin: p, IncBegin: true, End: p, IncEnd: true}
+}
+
+// ContainsPoint returns true if p is in this ordered set.
+func (s OrderedSet[T]) ContainsPoint(p T) bool {
+	return s.Contains(point(p))
+}
+
+// Find returns the interval in this ordered set that contains p.
+func (s OrderedSet[T]) Find(p T) (Interval[T], bool) {
+	idx := s.searchLow(point(p))
+	if idx < len(s.intervals) && s.intervals[idx].Contains(point(p)) {
+		return s.intervals[idx], true
+	}
+	return Interval[T]{}, false
+}
+
+// Floor returns the last interval in this ordered set that contains p or
+// is before p.
+func (s OrderedSet[T]) Floor(p T) (Interval[T], bool) {
+	return s.at(s.searchHigh(point(p)) - 1)
+}
+
+// Ceil returns the first interval in this ordered set that contains p or
+// is after p.
+func (s OrderedSet[T]) Ceil(p T) (Interval[T], bool) {
+	return s.at(s.searchLow(point(p)))
+}
+
+// Prev returns the last interval in this ordered set that is entirely
+// before p.
+func (s OrderedSet[T]) Prev(p T) (Interval[T], bool) {
+	return s.at(s.searchLow(point(p)) - 1)
+}
+
+// Next returns the first interval in this ordered set that is entirely
+// after p.
+func (s OrderedSet[T]) Next(p T) (Interval[T], bool) {
+	return s.at(s.searchHigh(point(p)))
+}
+
+func (s OrderedSet[T]) at(idx int) (Interval[T], bool) {
+	if idx < 0 || idx >= len(s.intervals) {
+		return Interval[T]{}, false
+	}
+	return s.intervals[idx], true
+}
+
 // Intervals returns a copy of intervals in this ordered set.
-func (s OrderedSet) Intervals() []Interval {
-	return append([]Interval(nil), s.intervals...)
+func (s OrderedSet[T]) Intervals() []Interval[T] {
+	return append([]Interval[T](nil), s.intervals...)
 }
 
 // Iterator returns a iterator that iterates over all the intervals both in
 // this ordered set and bound.
 // If iterator returns empty Interval, the iteration is over.
 // If forward is true, the iteration from left to right.
-func (s OrderedSet) Iterator(bound Interval, forward bool) func() Interval {
+func (s OrderedSet[T]) Iterator(bound Interval[T], forward bool) func() Interval[T] {
 	if bound.IsEmpty() {
-		return emptyIterator
+		return emptyIterator[T]
 	}
 
 	low, high := s.searchLow(bound), s.searchHigh(bound)-1
@@ -123,9 +207,9 @@ func (s OrderedSet) Iterator(bound Interval, forward bool) func() Interval {
 	if !forward {
 		idx, stride = high, -1
 	}
-	return func() Interval {
+	return func() Interval[T] {
 		if idx < low || idx > high {
-			return Interval{}
+			return Interval[T]{}
 		}
 		x := s.intervals[idx]
 		idx += stride
@@ -133,9 +217,9 @@ func (s OrderedSet) Iterator(bound Interval, forward bool) func() Interval {
 	}
 }
 
-func emptyIterator() Interval { return Interval{} }
+func emptyIterator[T cmp.Ordered]() Interval[T] { return Interval[T]{} }
 
-func adjoinOrAppend(intervals []Interval, x Interval) []Interval {
+func adjoinOrAppend[T cmp.Ordered](intervals []Interval[T], x Interval[T]) []Interval[T] {
 	n := len(intervals)
 	switch n {
 	case 0:
@@ -151,9 +235,51 @@ func adjoinOrAppend(intervals []Interval, x Interval) []Interval {
 	}
 }
 
+// mergeOrAppend merges x into the last of intervals if they overlap or are
+// adjacent, otherwise it appends x. x must not begin before the last of
+// intervals.
+func mergeOrAppend[T cmp.Ordered](intervals []Interval[T], x Interval[T]) []Interval[T] {
+	n := len(intervals) - 1
+	if n < 0 {
+		return append(intervals, x)
+	}
+	if intervals[n].Intersect(x).IsEmpty() && intervals[n].Adjoin(x).IsEmpty() {
+		return append(intervals, x)
+	}
+	intervals[n] = intervals[n].Encompass(x)
+	return intervals
+}
+
+// AddAll adds all of intervals to this ordered set with one linear merge
+// after sorting intervals, which is cheaper than calling Add for each of
+// them.
+// AddAll returns true if this ordered set changed.
+func (s *OrderedSet[T]) AddAll(intervals ...Interval[T]) bool {
+	xs := normalize(intervals)
+	if len(xs) == 0 {
+		return false
+	}
+	merged := make([]Interval[T], 0, len(s.intervals)+len(xs))
+	i, j := 0, 0
+	for i < len(s.intervals) || j < len(xs) {
+		if j == len(xs) || (i < len(s.intervals) && compareBegin(s.intervals[i], xs[j]) <= 0) {
+			merged = mergeOrAppend(merged, s.intervals[i])
+			i++
+		} else {
+			merged = mergeOrAppend(merged, xs[j])
+			j++
+		}
+	}
+	if equalIntervals(s.intervals, merged) {
+		return false
+	}
+	s.intervals = merged
+	return true
+}
+
 // Add adds x interval to this ordered set.
 // Add returns true if this ordered set changed.
-func (s *OrderedSet) Add(x Interval) bool {
+func (s *OrderedSet[T]) Add(x Interval[T]) bool {
 	if x.IsEmpty() {
 		return false
 	}
@@ -177,9 +303,9 @@ func (s *OrderedSet) Add(x Interval) bool {
 		return false
 	}
 
-	newIntervals := make([]Interval, 0, len(s.intervals)+1)
+	newIntervals := make([]Interval[T], 0, len(s.intervals)+1)
 	newIntervals = append(newIntervals, s.intervals[:low]...)
-	push := func(i Interval) {
+	push := func(i Interval[T]) {
 		newIntervals = adjoinOrAppend(newIntervals, i)
 	}
 	if x.LtBeginOf(s.intervals[low]) {
@@ -254,7 +380,7 @@ func (s *OrderedSet) Add(x Interval) bool {
 
 // Remove removes x interval from this ordered set.
 // Remove returns true if this ordered set changed.
-func (s *OrderedSet) Remove(x Interval) bool {
+func (s *OrderedSet[T]) Remove(x Interval[T]) bool {
 	if s.IsEmpty() || x.IsEmpty() {
 		return false
 	}
@@ -393,7 +519,7 @@ func (s *OrderedSet) Remove(x Interval) bool {
 }
 
 // Union returns an ordered set containing all intervals in a or b.
-func Union(a, b OrderedSet) OrderedSet {
+func Union[T cmp.Ordered](a, b OrderedSet[T]) OrderedSet[T] {
 	if a.Len() < b.Len() {
 		a, b = b, a
 	}
@@ -410,9 +536,18 @@ func Union(a, b OrderedSet) OrderedSet {
 }
 
 // Intersect returns an ordered set containing all intervals of a that also belong to b.
-func Intersect(a, b OrderedSet) OrderedSet {
-	var intervals []Interval
-	xit, yit := a.Iterator(b.Bound(), true), b.Iterator(a.Bound(), true)
+func Intersect[T cmp.Ordered](a, b OrderedSet[T]) OrderedSet[T] {
+	var intervals []Interval[T]
+	intersect(a.Iterator(b.Bound(), true), b.Iterator(a.Bound(), true), func(x Interval[T]) bool {
+		intervals = append(intervals, x)
+		return true
+	})
+	return OrderedSet[T]{intervals: intervals}
+}
+
+// intersect pushes the intersection of intervals from xit and yit in order,
+// it stops if push returns false.
+func intersect[T cmp.Ordered](xit, yit func() Interval[T], push func(Interval[T]) bool) {
 	x, y := xit(), yit()
 	for !x.IsEmpty() && !y.IsEmpty() {
 		if x.LtBeginOf(y) {
@@ -422,7 +557,9 @@ func Intersect(a, b OrderedSet) OrderedSet {
 		} else {
 			in := x.Intersect(y)
 			if !in.IsEmpty() {
-				intervals = append(intervals, in)
+				if !push(in) {
+					return
+				}
 				_, right := x.Bisect(y)
 				if !right.IsEmpty() {
 					x = right
@@ -432,22 +569,34 @@ func Intersect(a, b OrderedSet) OrderedSet {
 			}
 		}
 	}
-	return OrderedSet{intervals: intervals}
 }
 
 // Subtract returns an ordered set containing all intervals in a but not in b.
-func Subtract(a, b OrderedSet) OrderedSet {
-	var intervals []Interval
-	xit, yit := a.Iterator(a.Bound(), true), b.Iterator(a.Bound(), true)
+func Subtract[T cmp.Ordered](a, b OrderedSet[T]) OrderedSet[T] {
+	var intervals []Interval[T]
+	subtract(a.Iterator(a.Bound(), true), b.Iterator(a.Bound(), true), func(x Interval[T]) bool {
+		intervals = append(intervals, x)
+		return true
+	})
+	return OrderedSet[T]{intervals: intervals}
+}
+
+// subtract pushes intervals from xit but not from yit in order,
+// it stops if push returns false.
+func subtract[T cmp.Ordered](xit, yit func() Interval[T], push func(Interval[T]) bool) {
 	x, y := xit(), yit()
 	for !x.IsEmpty() {
 		if y.IsEmpty() {
-			intervals = append(intervals, x)
+			if !push(x) {
+				return
+			}
 			x = xit()
 		} else {
 			left, right := x.Bisect(y)
 			if !left.IsEmpty() {
-				intervals = append(intervals, left)
+				if !push(left) {
+					return
+				}
 			}
 			if right.IsEmpty() {
 				x = xit()
@@ -457,42 +606,58 @@ func Subtract(a, b OrderedSet) OrderedSet {
 			}
 		}
 	}
-	return OrderedSet{intervals: intervals}
 }
 
 // Difference returns an ordered set containing all intervals in either of a and b,
 // but not in their intersection.
-func Difference(a, b OrderedSet) OrderedSet {
-	var intervals []Interval
-	push := func(x Interval) {
+func Difference[T cmp.Ordered](a, b OrderedSet[T]) OrderedSet[T] {
+	var intervals []Interval[T]
+	difference(a.Iterator(a.Bound(), true), b.Iterator(b.Bound(), true), func(x Interval[T]) bool {
 		intervals = adjoinOrAppend(intervals, x)
-	}
-	xit, yit := a.Iterator(a.Bound(), true), b.Iterator(b.Bound(), true)
+		return true
+	})
+	return OrderedSet[T]{intervals: intervals}
+}
+
+// difference pushes intervals in either of xit and yit but not in both in
+// order, adjacent intervals are pushed separately. It stops if push returns
+// false.
+func difference[T cmp.Ordered](xit, yit func() Interval[T], push func(Interval[T]) bool) {
 	x, y := xit(), yit()
 	for {
 		if x.IsEmpty() {
 			if y.IsEmpty() {
 				break
 			}
-			push(y)
+			if !push(y) {
+				return
+			}
 			y = yit()
 		} else if y.IsEmpty() {
-			push(x)
+			if !push(x) {
+				return
+			}
 			x = xit()
 		} else {
 			//     ======  ===   ======= ======== ==== ======
 			//===   ==  *==*     =======   =========     ======
 			if x.LtBeginOf(y) {
-				push(x)
+				if !push(x) {
+					return
+				}
 				x = xit()
 			} else if y.LtBeginOf(x) {
-				push(y)
+				if !push(y) {
+					return
+				}
 				y = yit()
 			} else {
 				leftx, rightx := x.Bisect(y)
 				lefty, righty := y.Bisect(x)
 				if !leftx.IsEmpty() {
-					push(leftx)
+					if !push(leftx) {
+						return
+					}
 				}
 				if rightx.IsEmpty() {
 					x = xit()
@@ -500,7 +665,9 @@ func Difference(a, b OrderedSet) OrderedSet {
 					x = rightx
 				}
 				if !lefty.IsEmpty() {
-					push(lefty)
+					if !push(lefty) {
+						return
+					}
 				}
 				if righty.IsEmpty() {
 					y = yit()
@@ -510,5 +677,41 @@ func Difference(a, b OrderedSet) OrderedSet {
 			}
 		}
 	}
-	return OrderedSet{intervals: intervals}
+}
+
+// Complement returns an ordered set containing all intervals in universe
+// but not in s.
+func Complement[T cmp.Ordered](s OrderedSet[T], universe Interval[T]) OrderedSet[T] {
+	var intervals []Interval[T]
+	complement(s.Iterator(universe, true), universe, func(x Interval[T]) bool {
+		intervals = append(intervals, x)
+		return true
+	})
+	return OrderedSet[T]{intervals: intervals}
+}
+
+// complement pushes the gaps between intervals from it within universe in
+// order, it stops if push returns false.
+func complement[T cmp.Ordered](it func() Interval[T], universe Interval[T], push func(Interval[T]) bool) {
+	rest := universe
+	for !rest.IsEmpty() {
+		x := it()
+		if x.IsEmpty() {
+			push(rest)
+			return
+		}
+		left, right := rest.Bisect(x)
+		if !left.IsEmpty() {
+			if !push(left) {
+				return
+			}
+		}
+		rest = right
+	}
+}
+
+// ComplementUnbounded returns an ordered set containing all intervals
+// in (-inf, +inf) but not in s.
+func ComplementUnbounded[T cmp.Ordered](s OrderedSet[T]) OrderedSet[T] {
+	return Complement(s, Interval[T]{UnboundedBegin: true, UnboundedEnd: true})
 }
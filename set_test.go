@@ -5,19 +5,19 @@ import (
 	"testing"
 )
 
-func parseOrderedSet(s string) OrderedSet {
+func parseOrderedSet(s string) OrderedSet[int] {
 	if s == "" {
-		return OrderedSet{}
+		return OrderedSet[int]{}
 	}
 
-	var intervals []Interval
+	var intervals []Interval[int]
 	var begin = -1
 	var incBegin bool
 	for i := 0; i < len(s); i++ {
 		switch s[i] {
 		case '-', ' ':
 			if begin != -1 {
-				intervals = append(intervals, Interval{
+				intervals = append(intervals, Interval[int]{
 					Begin:    begin,
 					IncBegin: incBegin,
 					End:      i - 1,
@@ -25,7 +25,7 @@ func parseOrderedSet(s string) OrderedSet {
 				})
 				begin = -1
 			}
-		case '=':
+		case '=', '<', '>':
 			if begin == -1 {
 				begin = i
 				incBegin = true
@@ -35,7 +35,7 @@ func parseOrderedSet(s string) OrderedSet {
 				begin = i
 				incBegin = false
 			} else {
-				intervals = append(intervals, Interval{
+				intervals = append(intervals, Interval[int]{
 					Begin:    begin,
 					IncBegin: incBegin,
 					End:      i,
@@ -46,7 +46,7 @@ func parseOrderedSet(s string) OrderedSet {
 		case 'f':
 			// (i,
 			if begin != -1 {
-				intervals = append(intervals, Interval{
+				intervals = append(intervals, Interval[int]{
 					Begin:    begin,
 					IncBegin: incBegin,
 					End:      i - 1,
@@ -57,7 +57,7 @@ func parseOrderedSet(s string) OrderedSet {
 			incBegin = false
 		case 'p': // [i,i]
 			if begin != -1 {
-				intervals = append(intervals, Interval{
+				intervals = append(intervals, Interval[int]{
 					Begin:    begin,
 					IncBegin: incBegin,
 					End:      i - 1,
@@ -65,7 +65,7 @@ func parseOrderedSet(s string) OrderedSet {
 				})
 				begin = -1
 			}
-			intervals = append(intervals, Interval{
+			intervals = append(intervals, Interval[int]{
 				Begin:    i,
 				IncBegin: true,
 				End:      i,
@@ -73,7 +73,7 @@ func parseOrderedSet(s string) OrderedSet {
 			})
 		case 'e': // , e)(e,
 			if begin != -1 {
-				intervals = append(intervals, Interval{
+				intervals = append(intervals, Interval[int]{
 					Begin:    begin,
 					IncBegin: incBegin,
 					End:      i,
@@ -87,14 +87,21 @@ func parseOrderedSet(s string) OrderedSet {
 		}
 	}
 	if begin != -1 {
-		intervals = append(intervals, Interval{
+		intervals = append(intervals, Interval[int]{
 			Begin:    begin,
 			IncBegin: incBegin,
 			End:      len(s) - 1,
 			IncEnd:   true,
 		})
 	}
-	return OrderedSet{intervals: intervals}
+	// '<' and '>' mark the unbounded begin and end of the set.
+	if s[0] == '<' {
+		intervals[0].UnboundedBegin = true
+	}
+	if s[len(s)-1] == '>' {
+		intervals[len(intervals)-1].UnboundedEnd = true
+	}
+	return OrderedSet[int]{intervals: intervals}
 }
 
 func TestOrderedSet_Iterator(t *testing.T) {
@@ -158,6 +165,11 @@ func TestOrderedSet_Iterator(t *testing.T) {
 			b: "                              ========",
 			w: "                             ===  =",
 		},
+		{ // 12
+			s: "<==   === ====      ===     ===  =>",
+			b: "<>",
+			w: "<==   === ====      ===     ===  =>",
+		},
 	}
 	for n, tc := range itCases {
 		t.Run(fmt.Sprint(n), func(t *testing.T) {
@@ -165,7 +177,7 @@ func TestOrderedSet_Iterator(t *testing.T) {
 			b := parseInterval(tc.b)
 			w := parseOrderedSet(tc.w)
 			it := s.Iterator(b, true)
-			var intervals []Interval
+			var intervals []Interval[int]
 			for {
 				i := it()
 				if i.IsEmpty() {
@@ -265,6 +277,18 @@ func TestOrderedSet_Add(t *testing.T) {
 			w: "      ================== =========== ========= ======= == ====",
 			c: true,
 		},
+		{ // 13
+			s: "<==    ==>",
+			a: "  ====",
+			w: "<===== ==>",
+			c: true,
+		},
+		{ // 14
+			s: "<==    ==>",
+			a: "<>",
+			w: "<>",
+			c: true,
+		},
 	}
 
 	for n, tc := range addCases {
@@ -405,6 +429,18 @@ func TestOrderedSet_Remove(t *testing.T) {
 			w: "      === ===== =*       =========== ========= ======= == ====",
 			c: true,
 		},
+		{ // 19
+			s: "<>",
+			r: "  ===",
+			w: "<=* *>",
+			c: true,
+		},
+		{ // 20
+			s: "<==    ==>",
+			r: "<>",
+			w: "",
+			c: true,
+		},
 	}
 
 	for n, tc := range removeCases {
@@ -551,6 +587,18 @@ func TestUnion(t *testing.T) {
 
 			w: "===",
 		},
+		{ // 20
+			a: "<==   ===",
+			b: "  *=====",
+
+			w: "<========",
+		},
+		{ // 21
+			a: "<==",
+			b: "    ==>",
+
+			w: "<== ===>",
+		},
 	}
 	for n, tc := range unionCases {
 		t.Run(fmt.Sprint(n), func(t *testing.T) {
@@ -617,6 +665,11 @@ func TestIntersect(t *testing.T) {
 			b: "===* ====* *=====*   *=====**====",
 			w: "  =*   ==*    ===*     ====*",
 		},
+		{ // 9
+			a: "<==",
+			b: "  ==>",
+			w: "  =",
+		},
 	}
 	for n, tc := range intersectCases {
 		t.Run(fmt.Sprint(n), func(t *testing.T) {
@@ -696,6 +749,12 @@ func TestSubtract(t *testing.T) {
 
 			w: "    ==          ==",
 		},
+		{ // 9
+			a: "<>",
+			b: "  ===  ==",
+
+			w: "<=* *==**>",
+		},
 	}
 	for n, tc := range subtractCases {
 		t.Run(fmt.Sprint(n), func(t *testing.T) {
@@ -759,6 +818,12 @@ func TestDifference(t *testing.T) {
 
 			w: "===*==========* ======",
 		},
+		{ // 7
+			a: "<==",
+			b: "  ==>",
+
+			w: "<=e>",
+		},
 	}
 	for n, tc := range differenceCases {
 		t.Run(fmt.Sprint(n), func(t *testing.T) {
@@ -776,3 +841,289 @@ func TestDifference(t *testing.T) {
 		})
 	}
 }
+
+func TestOrderedSet_Ordered(t *testing.T) {
+	var a, b OrderedSet[string]
+	a.Add(Interval[string]{Begin: "a", IncBegin: true, End: "f"})
+	a.Add(Interval[string]{Begin: "m", IncBegin: true, End: "t"})
+	b.Add(Interval[string]{Begin: "d", IncBegin: true, End: "p"})
+
+	w := OrderedSet[string]{intervals: []Interval[string]{
+		{Begin: "a", IncBegin: true, End: "t"},
+	}}
+	if s := Union(a, b); !s.Equal(w) {
+		t.Errorf("want Union(%s, %s) = %s but get %s", a, b, w, s)
+	}
+	w = OrderedSet[string]{intervals: []Interval[string]{
+		{Begin: "d", IncBegin: true, End: "f"},
+		{Begin: "m", IncBegin: true, End: "p"},
+	}}
+	if s := Intersect(a, b); !s.Equal(w) {
+		t.Errorf("want Intersect(%s, %s) = %s but get %s", a, b, w, s)
+	}
+
+	var c IntOrderedSet
+	c.Add(IntInterval{Begin: 0, IncBegin: true, End: 10})
+	if !c.Contains(Interval[int]{Begin: 2, IncBegin: true, End: 3}) {
+		t.Errorf("want %s contains [2, 3)", c)
+	}
+}
+
+func TestComplement(t *testing.T) {
+	var complementCases = []struct {
+		s string
+		u string
+
+		w string
+	}{
+		{ // 0
+			s: "",
+			u: "",
+
+			w: "",
+		},
+		{ // 1
+			s: "===",
+			u: "",
+
+			w: "",
+		},
+		{ // 2
+			s: "",
+			u: "=====",
+
+			w: "=====",
+		},
+		{ // 3
+			s: "  ===   *==*  ==",
+			u: "==============",
+
+			w: "==* *====  ===",
+		},
+		{ // 4
+			s: "  ===   *==*  ==",
+			u: "   *=======*",
+
+			w: "    *====",
+		},
+		{ // 5
+			s: "  ===",
+			u: "  ===",
+
+			w: "",
+		},
+		{ // 6
+			s: "  === =",
+			u: "<>",
+
+			w: "<=* *=e>",
+		},
+		{ // 7
+			s: "<== ==>",
+			u: "<>",
+
+			w: "  *=*",
+		},
+	}
+	for n, tc := range complementCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			s := parseOrderedSet(tc.s)
+			u := parseInterval(tc.u)
+			w := parseOrderedSet(tc.w)
+			c := Complement(s, u)
+			if !c.Equal(w) {
+				t.Errorf("want Complement(%s, %s) = %s but get %s", s, u, w, c)
+			}
+			if u.UnboundedBegin && u.UnboundedEnd {
+				c = ComplementUnbounded(s)
+				if !c.Equal(w) {
+					t.Errorf("want ComplementUnbounded(%s) = %s but get %s", s, w, c)
+				}
+			}
+		})
+	}
+}
+
+func TestFromIntervals(t *testing.T) {
+	var fromCases = []struct {
+		intervals []string
+		w         string
+	}{
+		{ // 0
+			intervals: nil,
+			w:         "",
+		},
+		{ // 1
+			intervals: []string{"", "  ==", ""},
+			w:         "  ==",
+		},
+		{ // 2
+			intervals: []string{"      ===", "==", "   ="},
+			w:         "== =  ===",
+		},
+		{ // 3
+			intervals: []string{"    ===", "=*", " ==", "      ====*"},
+			w:         "=== ======*",
+		},
+		{ // 4
+			intervals: []string{"  *==", "==*", "      =>", " =="},
+			w:         "===== =>",
+		},
+	}
+	for n, tc := range fromCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			var intervals []Interval[int]
+			for _, i := range tc.intervals {
+				intervals = append(intervals, parseInterval(i))
+			}
+			w := parseOrderedSet(tc.w)
+			s := FromIntervals(intervals)
+			if !s.Equal(w) {
+				t.Errorf("want FromIntervals(%v) = %s but get %s", intervals, w, s)
+			}
+			if s = NewOrderedSet(intervals...); !s.Equal(w) {
+				t.Errorf("want NewOrderedSet(%v) = %s but get %s", intervals, w, s)
+			}
+		})
+	}
+}
+
+func TestOrderedSet_AddAll(t *testing.T) {
+	var addAllCases = []struct {
+		s         string
+		intervals []string
+		w         string
+		c         bool
+	}{
+		{ // 0
+			s:         "",
+			intervals: nil,
+			w:         "",
+			c:         false,
+		},
+		{ // 1
+			s:         "===   ===",
+			intervals: []string{" =", "    ===", "        =="},
+			w:         "=== ======",
+			c:         true,
+		},
+		{ // 2
+			s:         "===   ===",
+			intervals: []string{"  =", "       ="},
+			w:         "===   ===",
+			c:         false,
+		},
+		{ // 3
+			s:         "==*  *==  ===",
+			intervals: []string{"  =", "  *===*", "            ==>"},
+			w:         "========  ===>",
+			c:         true,
+		},
+	}
+	for n, tc := range addAllCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			s := parseOrderedSet(tc.s)
+			var intervals []Interval[int]
+			for _, i := range tc.intervals {
+				intervals = append(intervals, parseInterval(i))
+			}
+			w := parseOrderedSet(tc.w)
+			c := s.AddAll(intervals...)
+			if c != tc.c {
+				t.Errorf("want AddAll(%v) = %t but get %t", intervals, tc.c, c)
+			}
+			if !s.Equal(w) {
+				t.Errorf("want %s but get %s", w, s)
+			}
+		})
+	}
+}
+
+func TestOrderedSet_PointQueries(t *testing.T) {
+	s := parseOrderedSet("  ==*  *==   =")
+	type result struct {
+		i  string
+		ok bool
+	}
+	var pointCases = []struct {
+		p     int
+		find  result
+		floor result
+		ceil  result
+		prev  result
+		next  result
+	}{
+		{ // 0
+			p:     0,
+			find:  result{"", false},
+			floor: result{"", false},
+			ceil:  result{"  ==*", true},
+			prev:  result{"", false},
+			next:  result{"  ==*", true},
+		},
+		{ // 1
+			p:     3,
+			find:  result{"  ==*", true},
+			floor: result{"  ==*", true},
+			ceil:  result{"  ==*", true},
+			prev:  result{"", false},
+			next:  result{"       *==", true},
+		},
+		{ // 2
+			p:     4,
+			find:  result{"", false},
+			floor: result{"  ==*", true},
+			ceil:  result{"       *==", true},
+			prev:  result{"  ==*", true},
+			next:  result{"       *==", true},
+		},
+		{ // 3
+			p:     7,
+			find:  result{"", false},
+			floor: result{"  ==*", true},
+			ceil:  result{"       *==", true},
+			prev:  result{"  ==*", true},
+			next:  result{"       *==", true},
+		},
+		{ // 4
+			p:     13,
+			find:  result{"             =", true},
+			floor: result{"             =", true},
+			ceil:  result{"             =", true},
+			prev:  result{"       *==", true},
+			next:  result{"", false},
+		},
+		{ // 5
+			p:     20,
+			find:  result{"", false},
+			floor: result{"             =", true},
+			ceil:  result{"", false},
+			prev:  result{"             =", true},
+			next:  result{"", false},
+		},
+	}
+	for n, tc := range pointCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			for _, q := range []struct {
+				name string
+				f    func(int) (Interval[int], bool)
+				w    result
+			}{
+				{"Find", s.Find, tc.find},
+				{"Floor", s.Floor, tc.floor},
+				{"Ceil", s.Ceil, tc.ceil},
+				{"Prev", s.Prev, tc.prev},
+				{"Next", s.Next, tc.next},
+			} {
+				w := parseInterval(q.w.i)
+				i, ok := q.f(tc.p)
+				if !i.Equal(w) || ok != q.w.ok {
+					t.Errorf("want %s.%s(%d) = %s, %t but get %s, %t", s, q.name, tc.p, w, q.w.ok, i, ok)
+				}
+			}
+			if c := s.ContainsPoint(tc.p); c != tc.find.ok {
+				t.Errorf("want %s.ContainsPoint(%d) = %t but get %t", s, tc.p, tc.find.ok, c)
+			}
+		})
+	}
+}
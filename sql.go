@@ -0,0 +1,291 @@
+package interval
+
+import (
+	"cmp"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// Value implements driver.Valuer, the interval is written in the textual
+// format of PostgreSQL range types, such as "[1,5)", "(,5]" or "empty".
+// Intervals with integer endpoints are written in the canonical form
+// "[a,b)" of discrete PostgreSQL ranges.
+func (i Interval[T]) Value() (driver.Value, error) {
+	var b strings.Builder
+	if err := writeRange(&b, i); err != nil {
+		return nil, err
+	}
+	return b.String(), nil
+}
+
+// Scan implements sql.Scanner, it reads the textual format of PostgreSQL
+// range types. Use sql.Null to scan a nullable column.
+func (i *Interval[T]) Scan(src any) error {
+	text, err := scanText(src)
+	if err != nil {
+		return err
+	}
+	p := parser{s: text}
+	x, err := scanRange[T](&p)
+	if err != nil {
+		return err
+	}
+	if !p.eof() {
+		return p.errorf("unexpected %q after range", p.s[p.pos])
+	}
+	*i = x
+	return nil
+}
+
+// Value implements driver.Valuer, the ordered set is written in the
+// textual format of PostgreSQL multirange types, such as "{[1,3),[7,9)}".
+func (s OrderedSet[T]) Value() (driver.Value, error) {
+	var b strings.Builder
+	b.WriteByte('{')
+	for n, i := range s.intervals {
+		if n > 0 {
+			b.WriteByte(',')
+		}
+		if err := writeRange(&b, i); err != nil {
+			return nil, err
+		}
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
+// Scan implements sql.Scanner, it reads the textual format of PostgreSQL
+// multirange types. Use sql.Null to scan a nullable column. Overlapping or
+// unordered ranges are merged.
+func (s *OrderedSet[T]) Scan(src any) error {
+	text, err := scanText(src)
+	if err != nil {
+		return err
+	}
+	p := parser{s: text}
+	var intervals []Interval[T]
+	p.skipSpace()
+	if err := p.expect('{'); err != nil {
+		return err
+	}
+	// whitespace is allowed around the ranges, as in "{[1,3), [7,9)}".
+	p.skipSpace()
+	for first := true; !p.accept('}'); first = false {
+		if !first {
+			if err := p.expect(','); err != nil {
+				return err
+			}
+			p.skipSpace()
+		}
+		i, err := scanRange[T](&p)
+		if err != nil {
+			return err
+		}
+		intervals = append(intervals, i)
+		p.skipSpace()
+	}
+	p.skipSpace()
+	if !p.eof() {
+		return p.errorf("unexpected %q after multirange", p.s[p.pos])
+	}
+	*s = FromIntervals(intervals)
+	return nil
+}
+
+func scanText(src any) (string, error) {
+	switch src := src.(type) {
+	case string:
+		return src, nil
+	case []byte:
+		return string(src), nil
+	case nil:
+		return "", errors.New("interval: can not scan NULL")
+	}
+	return "", fmt.Errorf("interval: can not scan %T", src)
+}
+
+func writeRange[T cmp.Ordered](b *strings.Builder, i Interval[T]) error {
+	if i.IsEmpty() {
+		b.WriteString("empty")
+		return nil
+	}
+	i, err := canonicalRange(i)
+	if err != nil {
+		return err
+	}
+	if i.IncBegin && !i.UnboundedBegin {
+		b.WriteByte('[')
+	} else {
+		b.WriteByte('(')
+	}
+	if !i.UnboundedBegin {
+		writeRangeValue(b, i.Begin)
+	}
+	b.WriteByte(',')
+	if !i.UnboundedEnd {
+		writeRangeValue(b, i.End)
+	}
+	if i.IncEnd && !i.UnboundedEnd {
+		b.WriteByte(']')
+	} else {
+		b.WriteByte(')')
+	}
+	return nil
+}
+
+func writeRangeValue[T cmp.Ordered](b *strings.Builder, v T) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.String {
+		b.WriteString(formatValue(v))
+		return
+	}
+	b.WriteByte('"')
+	for _, r := range rv.String() {
+		if r == '"' || r == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('"')
+}
+
+// canonicalRange converts an interval with integer endpoints into the
+// canonical "[a,b)" form of discrete PostgreSQL ranges.
+func canonicalRange[T cmp.Ordered](i Interval[T]) (Interval[T], error) {
+	if !i.UnboundedBegin && !i.IncBegin {
+		b := reflect.ValueOf(&i.Begin).Elem()
+		if ok, err := incrementInteger(b); err != nil {
+			return i, err
+		} else if ok {
+			i.IncBegin = true
+		}
+	}
+	if !i.UnboundedEnd && i.IncEnd {
+		e := reflect.ValueOf(&i.End).Elem()
+		if ok, err := incrementInteger(e); err != nil {
+			return i, err
+		} else if ok {
+			i.IncEnd = false
+		}
+	}
+	return i, nil
+}
+
+// incrementInteger adds 1 to v, it returns false if v is not an integer.
+func incrementInteger(v reflect.Value) (bool, error) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		x := v.Int()
+		if x == math.MaxInt64 || v.OverflowInt(x+1) {
+			return false, fmt.Errorf("interval: %d+1 is out of range of %s", x, v.Type())
+		}
+		v.SetInt(x + 1)
+		return true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		x := v.Uint()
+		if x == math.MaxUint64 || v.OverflowUint(x+1) {
+			return false, fmt.Errorf("interval: %d+1 is out of range of %s", x, v.Type())
+		}
+		v.SetUint(x + 1)
+		return true, nil
+	}
+	return false, nil
+}
+
+// scanRange parses the textual format of a PostgreSQL range.
+func scanRange[T cmp.Ordered](p *parser) (Interval[T], error) {
+	var i Interval[T]
+	if len(p.s)-p.pos >= len("empty") && strings.EqualFold(p.s[p.pos:p.pos+len("empty")], "empty") {
+		p.pos += len("empty")
+		return i, nil
+	}
+	switch {
+	case p.accept('['):
+		i.IncBegin = true
+	case p.accept('('):
+	default:
+		return i, p.errorf("expected '[', '(' or empty")
+	}
+
+	off := p.pos
+	raw, ok, err := scanRangeValue(p)
+	if err != nil {
+		return i, err
+	}
+	if !ok {
+		i.IncBegin = false
+		i.UnboundedBegin = true
+	} else if i.Begin, err = rangeValue[T](raw); err != nil {
+		return i, p.errorAt(off, err.Error())
+	}
+
+	if err := p.expect(','); err != nil {
+		return i, err
+	}
+
+	off = p.pos
+	if raw, ok, err = scanRangeValue(p); err != nil {
+		return i, err
+	}
+	if !ok {
+		i.UnboundedEnd = true
+	} else if i.End, err = rangeValue[T](raw); err != nil {
+		return i, p.errorAt(off, err.Error())
+	}
+
+	switch {
+	case p.accept(']'):
+		i.IncEnd = !i.UnboundedEnd
+	case p.accept(')'):
+	default:
+		return i, p.errorf("expected ']' or ')'")
+	}
+	return i, nil
+}
+
+// scanRangeValue returns the unquoted text of a range bound, it returns
+// false if the bound is omitted.
+func scanRangeValue(p *parser) (string, bool, error) {
+	var b strings.Builder
+	quoted := false
+	for !p.eof() {
+		c := p.s[p.pos]
+		switch {
+		case c == '"' && quoted && p.pos+1 < len(p.s) && p.s[p.pos+1] == '"':
+			b.WriteByte('"')
+			p.pos += 2
+			continue
+		case c == '"':
+			quoted = !quoted
+		case c == '\\':
+			p.pos++
+			if p.eof() {
+				return "", false, p.errorf("expected escaped character")
+			}
+			b.WriteByte(p.s[p.pos])
+		case !quoted && strings.IndexByte(",)]", c) >= 0:
+			return b.String(), b.Len() > 0 || p.s[p.pos-1] == '"', nil
+		default:
+			b.WriteByte(c)
+		}
+		p.pos++
+	}
+	if quoted {
+		return "", false, p.errorf("unterminated quoted bound")
+	}
+	return "", false, p.errorf("expected ',', ']' or ')'")
+}
+
+// rangeValue parses the unquoted text of a range bound.
+func rangeValue[T cmp.Ordered](s string) (T, error) {
+	var v T
+	if rv := reflect.ValueOf(&v).Elem(); rv.Kind() == reflect.String {
+		rv.SetString(s)
+		return v, nil
+	}
+	return parseValue[T](strings.TrimSpace(s))
+}
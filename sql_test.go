@@ -0,0 +1,176 @@
+package interval
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestInterval_SQL(t *testing.T) {
+	var sqlCases = []struct {
+		// value scanned from PostgreSQL.
+		s string
+		w string
+		// value written to PostgreSQL.
+		v string
+	}{
+		{ // 0
+			s: "empty",
+			w: "",
+			v: "empty",
+		},
+		{ // 1
+			s: "[1,5)",
+			w: "-====*",
+			v: "[1,5)",
+		},
+		{ // 2
+			s: "(,5)",
+			w: "<====*",
+			v: "(,5)",
+		},
+		{ // 3
+			s: "[3,)",
+			w: "---=>",
+			v: "[3,)",
+		},
+		{ // 4
+			s: "(,)",
+			w: "<>",
+			v: "(,)",
+		},
+		{ // 5
+			s: "(1,5]",
+			w: "-*====",
+			v: "[2,6)",
+		},
+		{ // 6
+			s: "[ 1 , 5 ]",
+			w: "-=====",
+			v: "[1,6)",
+		},
+		{ // 7
+			s: "EMPTY",
+			w: "",
+			v: "empty",
+		},
+	}
+	for n, tc := range sqlCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			var i Interval[int]
+			if err := i.Scan(tc.s); err != nil {
+				t.Fatalf("want Scan(%q) succeeds but get %v", tc.s, err)
+			}
+			w := parseInterval(tc.w)
+			if !i.Equal(w) {
+				t.Errorf("want Scan(%q) = %s but get %s", tc.s, w, i)
+			}
+			v, err := i.Value()
+			if err != nil || v != tc.v {
+				t.Errorf("want %s.Value() = %q but get %q, %v", i, tc.v, v, err)
+			}
+		})
+	}
+
+	var i Interval[int]
+	for _, src := range []any{nil, 12, "[1,5", "[a,5)", "1,5", "[1,5)x", "emp"} {
+		if err := i.Scan(src); err == nil {
+			t.Errorf("want Scan(%#v) fails but get %s", src, i)
+		}
+	}
+
+	i = Interval[int]{Begin: 1, IncBegin: true, End: math.MaxInt, IncEnd: true}
+	if v, err := i.Value(); err == nil {
+		t.Errorf("want %s.Value() fails but get %q", i, v)
+	}
+
+	var n sql.Null[Interval[int]]
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Errorf("want sql.Null Scan(nil) is invalid but get %v, %v", n, err)
+	}
+}
+
+func TestInterval_SQLOrdered(t *testing.T) {
+	var s Interval[string]
+	if err := s.Scan([]byte(`["a b","c\"d")`)); err != nil {
+		t.Fatalf("want Scan succeeds but get %v", err)
+	}
+	w := Interval[string]{Begin: "a b", IncBegin: true, End: `c"d`}
+	if !s.Equal(w) {
+		t.Errorf("want Scan = %s but get %s", w, s)
+	}
+	if v, err := s.Value(); err != nil || v != `["a b","c\"d")` {
+		t.Errorf("want %s.Value() = %q but get %q, %v", s, `["a b","c\"d")`, v, err)
+	}
+
+	var f Interval[float64]
+	if err := f.Scan("(1.5,2.25]"); err != nil {
+		t.Fatalf("want Scan succeeds but get %v", err)
+	}
+	if v, err := f.Value(); err != nil || v != "(1.5,2.25]" {
+		t.Errorf("want %s.Value() = %q but get %q, %v", f, "(1.5,2.25]", v, err)
+	}
+}
+
+func TestOrderedSet_SQL(t *testing.T) {
+	var sqlCases = []struct {
+		s string
+		w string
+		v string
+	}{
+		{ // 0
+			s: "{}",
+			w: "",
+			v: "{}",
+		},
+		{ // 1
+			s: "{[1,3),[7,9)}",
+			w: "-==*   ==*",
+			v: "{[1,3),[7,9)}",
+		},
+		{ // 2
+			s: "{(,3),[7,)}",
+			w: "<==*   =>",
+			v: "{(,3),[7,)}",
+		},
+		{ // 3
+			s: "{[7,9),[1,3),[2,4)}",
+			w: "-===*  ==*",
+			v: "{[1,4),[7,9)}",
+		},
+		{ // 4
+			s: "{[1,3), [7,9)}",
+			w: "-==*   ==*",
+			v: "{[1,3),[7,9)}",
+		},
+		{ // 5
+			s: " { [1,3) ,\t[7,9) } ",
+			w: "-==*   ==*",
+			v: "{[1,3),[7,9)}",
+		},
+	}
+	for n, tc := range sqlCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			var s OrderedSet[int]
+			if err := s.Scan(tc.s); err != nil {
+				t.Fatalf("want Scan(%q) succeeds but get %v", tc.s, err)
+			}
+			w := parseOrderedSet(tc.w)
+			if !s.Equal(w) {
+				t.Errorf("want Scan(%q) = %s but get %s", tc.s, w, s)
+			}
+			v, err := s.Value()
+			if err != nil || v != tc.v {
+				t.Errorf("want %s.Value() = %q but get %q, %v", s, tc.v, v, err)
+			}
+		})
+	}
+
+	var s OrderedSet[int]
+	for _, src := range []any{nil, "[1,3)", "{[1,3)", "{[1,3),}", "{[1,3), }", "{[1,3)[4,5)}", "{[1,3) [4,5)}"} {
+		if err := s.Scan(src); err == nil {
+			t.Errorf("want Scan(%#v) fails but get %s", src, s)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package interval
+
+import (
+	"cmp"
+	"math"
+	"math/bits"
+)
+
+// Integer is a constraint that permits any integer endpoint type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// length returns End - Begin of a non-empty interval, it returns false if
+// the interval is unbounded. The difference always fits uint64, even for
+// the full range of int64.
+func length[T Integer](i Interval[T]) (uint64, bool) {
+	if i.UnboundedBegin || i.UnboundedEnd {
+		return math.MaxUint64, false
+	}
+	return uint64(i.End) - uint64(i.Begin), true
+}
+
+// cardinality returns the number of integer points in a non-empty interval,
+// it returns false if the interval is unbounded or the number overflows
+// uint64.
+func cardinality[T Integer](i Interval[T]) (uint64, bool) {
+	l, ok := length(i)
+	if !ok {
+		return l, false
+	}
+	switch {
+	case i.IncBegin && i.IncEnd:
+		if l == math.MaxUint64 {
+			return l, false
+		}
+		return l + 1, true
+	case !i.IncBegin && !i.IncEnd:
+		return l - 1, true
+	}
+	return l, true
+}
+
+// addSaturated returns a + b, it returns math.MaxUint64 and false if the sum
+// overflows.
+func addSaturated(a, b uint64) (uint64, bool) {
+	sum, carry := bits.Add64(a, b, 0)
+	if carry != 0 {
+		return math.MaxUint64, false
+	}
+	return sum, true
+}
+
+// addFloat returns a + b, it never saturates.
+func addFloat(a, b float64) (float64, bool) {
+	return a + b, true
+}
+
+// Measure returns the total length of intervals in s, the sum of
+// End - Begin. Measure returns math.MaxUint64 and false if s is unbounded.
+// It is exact for integer endpoints, use Length for float endpoints.
+func Measure[T Integer](s OrderedSet[T]) (uint64, bool) {
+	return sum(s.intervals, length[T], addSaturated)
+}
+
+// Length returns the total length of intervals in s as a float64 like Area
+// and Volume, it is +Inf if s is unbounded. Unlike Measure it accepts float
+// endpoints, but it may round the length of integer sets above 2^53.
+func Length[T Number](s OrderedSet[T]) float64 {
+	l, _ := sum(s.intervals, func(i Interval[T]) (float64, bool) {
+		w := width(i)
+		return w, !math.IsInf(w, 1)
+	}, addFloat)
+	return l
+}
+
+// Cardinality returns the number of integer points in s, honouring
+// IncBegin and IncEnd. Cardinality returns math.MaxUint64 and false if s is
+// unbounded or the number overflows uint64, which only happens for the full
+// range of a 64-bit integer type.
+func Cardinality[T Integer](s OrderedSet[T]) (uint64, bool) {
+	return sum(s.intervals, cardinality[T], addSaturated)
+}
+
+// sum returns the total of f over intervals added up with add, it stops
+// with false at the first interval that f or add saturates.
+func sum[T cmp.Ordered, N any](intervals []Interval[T], f func(Interval[T]) (N, bool), add func(a, b N) (N, bool)) (N, bool) {
+	var total N
+	for _, i := range intervals {
+		x, ok := f(i)
+		if !ok {
+			return x, false
+		}
+		if total, ok = add(total, x); !ok {
+			return total, false
+		}
+	}
+	return total, true
+}
+
+// SetStats is the shape of an ordered set reported by Stats.
+type SetStats[T Integer] struct {
+	// Count is the number of intervals.
+	Count int
+	// Measure is the result of Measure.
+	Measure uint64
+	// Cardinality is the result of Cardinality.
+	Cardinality uint64
+	// if Exact is false, the set is unbounded or its cardinality
+	// overflows, Measure and Cardinality are saturated at math.MaxUint64.
+	Exact bool
+	// MeanLength is Measure divided by Count, it is +Inf if the set is
+	// unbounded and 0 if the set is empty.
+	MeanLength float64
+
+	// LargestInterval is the first of the longest intervals.
+	LargestInterval Interval[T]
+	// SmallestGap and LargestGap are the first of the shortest and the
+	// longest intervals between two intervals of the set, they are empty if
+	// the set has less than two intervals.
+	SmallestGap, LargestGap Interval[T]
+}
+
+// Stats returns the measure, cardinality and shape statistics of s.
+func Stats[T Integer](s OrderedSet[T]) SetStats[T] {
+	st := SetStats[T]{Count: len(s.intervals)}
+	var mok, cok bool
+	st.Measure, mok = Measure(s)
+	st.Cardinality, cok = Cardinality(s)
+	st.Exact = mok && cok
+	switch {
+	case st.Count == 0:
+	case !mok:
+		st.MeanLength = math.Inf(1)
+	default:
+		st.MeanLength = float64(st.Measure) / float64(st.Count)
+	}
+
+	var largest, smallestGap, largestGap uint64
+	for n, i := range s.intervals {
+		if l, _ := length(i); n == 0 || l > largest {
+			st.LargestInterval, largest = i, l
+		}
+		if n == 0 {
+			continue
+		}
+		prev := s.intervals[n-1]
+		gap := Interval[T]{Begin: prev.End, IncBegin: !prev.IncEnd, End: i.Begin, IncEnd: !i.IncBegin}
+		l, _ := length(gap)
+		if n == 1 || l < smallestGap {
+			st.SmallestGap, smallestGap = gap, l
+		}
+		if n == 1 || l > largestGap {
+			st.LargestGap, largestGap = gap, l
+		}
+	}
+	return st
+}
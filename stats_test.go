@@ -0,0 +1,122 @@
+package interval
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestMeasure(t *testing.T) {
+	var measureCases = []struct {
+		s  string
+		m  uint64
+		c  uint64
+		ok bool
+	}{
+		{ // 0
+			s:  "",
+			m:  0,
+			c:  0,
+			ok: true,
+		},
+		{ // 1
+			s:  "=",
+			m:  0,
+			c:  1,
+			ok: true,
+		},
+		{ // 2
+			s:  "===  *==*  ==*  *==",
+			m:  2 + 3 + 2 + 2,
+			c:  3 + 2 + 2 + 2,
+			ok: true,
+		},
+		{ // 3
+			s:  "===  =>",
+			m:  math.MaxUint64,
+			c:  math.MaxUint64,
+			ok: false,
+		},
+	}
+	for n, tc := range measureCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			s := parseOrderedSet(tc.s)
+			if m, ok := Measure(s); m != tc.m || ok != tc.ok {
+				t.Errorf("want Measure(%s) = %d, %t but get %d, %t", s, tc.m, tc.ok, m, ok)
+			}
+			if c, ok := Cardinality(s); c != tc.c || ok != tc.ok {
+				t.Errorf("want Cardinality(%s) = %d, %t but get %d, %t", s, tc.c, tc.ok, c, ok)
+			}
+			w := math.Inf(1)
+			if tc.ok {
+				w = float64(tc.m)
+			}
+			if l := Length(s); l != w {
+				t.Errorf("want Length(%s) = %v but get %v", s, w, l)
+			}
+		})
+	}
+}
+
+func TestMeasure_Overflow(t *testing.T) {
+	full := NewOrderedSet(Interval[int64]{Begin: math.MinInt64, IncBegin: true, End: math.MaxInt64, IncEnd: true})
+	if m, ok := Measure(full); m != math.MaxUint64 || !ok {
+		t.Errorf("want Measure(%s) = %d, true but get %d, %t", full, uint64(math.MaxUint64), m, ok)
+	}
+	if c, ok := Cardinality(full); c != math.MaxUint64 || ok {
+		t.Errorf("want Cardinality(%s) = %d, false but get %d, %t", full, uint64(math.MaxUint64), c, ok)
+	}
+
+	half := NewOrderedSet(Interval[int64]{Begin: math.MinInt64, IncBegin: true, End: math.MaxInt64})
+	if c, ok := Cardinality(half); c != math.MaxUint64 || !ok {
+		t.Errorf("want Cardinality(%s) = %d, true but get %d, %t", half, uint64(math.MaxUint64), c, ok)
+	}
+
+	bytes := NewOrderedSet(Interval[uint8]{Begin: 0, IncBegin: true, End: 255, IncEnd: true})
+	if c, ok := Cardinality(bytes); c != 256 || !ok {
+		t.Errorf("want Cardinality(%s) = 256, true but get %d, %t", bytes, c, ok)
+	}
+}
+
+func TestLength(t *testing.T) {
+	s, err := ParseOrderedSet[float64]("{[0.5, 1), (2, 2.25], [3, 3]}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l := Length(s); l != 0.75 {
+		t.Errorf("want Length(%s) = 0.75 but get %v", s, l)
+	}
+	s.Add(Interval[float64]{Begin: 5, UnboundedEnd: true})
+	if l := Length(s); !math.IsInf(l, 1) {
+		t.Errorf("want Length(%s) = +Inf but get %v", s, l)
+	}
+}
+
+func TestStats(t *testing.T) {
+	s := parseOrderedSet("==  *====*   =      ===")
+	st := Stats(s)
+	w := SetStats[int]{
+		Count:           4,
+		Measure:         1 + 5 + 0 + 2,
+		Cardinality:     2 + 4 + 1 + 3,
+		Exact:           true,
+		MeanLength:      8.0 / 4,
+		LargestInterval: parseInterval("    *====*"),
+		SmallestGap:     parseInterval(" *==="),
+		LargestGap:      parseInterval("             *======*"),
+	}
+	if st.Count != w.Count || st.Measure != w.Measure || st.Cardinality != w.Cardinality ||
+		st.Exact != w.Exact || st.MeanLength != w.MeanLength ||
+		!st.LargestInterval.Equal(w.LargestInterval) ||
+		!st.SmallestGap.Equal(w.SmallestGap) || !st.LargestGap.Equal(w.LargestGap) {
+		t.Errorf("want Stats(%s) = %+v but get %+v", s, w, st)
+	}
+
+	st = Stats(parseOrderedSet("<=  ="))
+	if st.Exact || !math.IsInf(st.MeanLength, 1) || !st.LargestInterval.UnboundedBegin {
+		t.Errorf("want unbounded Stats but get %+v", st)
+	}
+	if st = Stats(OrderedSet[int]{}); st.Count != 0 || st.MeanLength != 0 || !st.SmallestGap.IsEmpty() {
+		t.Errorf("want empty Stats but get %+v", st)
+	}
+}
@@ -0,0 +1,155 @@
+package interval
+
+import (
+	"cmp"
+	"container/heap"
+)
+
+// UnionAll returns an ordered set containing all intervals in any of sets.
+func UnionAll[T cmp.Ordered](sets ...OrderedSet[T]) OrderedSet[T] {
+	return sweep(sets, func(depth int) bool { return depth > 0 })
+}
+
+// IntersectAll returns an ordered set containing the intervals in every
+// one of sets. IntersectAll returns an empty set if sets is empty.
+func IntersectAll[T cmp.Ordered](sets ...OrderedSet[T]) OrderedSet[T] {
+	if len(sets) == 0 {
+		return OrderedSet[T]{}
+	}
+	return sweep(sets, func(depth int) bool { return depth == len(sets) })
+}
+
+// SymmetricDifferenceAll returns an ordered set containing the intervals
+// in an odd number of sets. For two sets it is the same as Difference.
+func SymmetricDifferenceAll[T cmp.Ordered](sets ...OrderedSet[T]) OrderedSet[T] {
+	return sweep(sets, func(depth int) bool { return depth%2 == 1 })
+}
+
+// AtLeast returns an ordered set containing the intervals in at least k of
+// sets, such as the points where a quorum of k hosts is available.
+// AtLeast returns (-inf, +inf) if k <= 0.
+func AtLeast[T cmp.Ordered](k int, sets ...OrderedSet[T]) OrderedSet[T] {
+	return sweep(sets, func(depth int) bool { return depth >= k })
+}
+
+// boundary is a position between points where the depth of coverage may
+// change: just before v, or just after v if after is true. inf is -1 or 1
+// for the boundaries at -inf and +inf.
+type boundary[T cmp.Ordered] struct {
+	v     T
+	after bool
+	inf   int
+}
+
+func compareBoundary[T cmp.Ordered](a, b boundary[T]) int {
+	if a.inf != 0 || b.inf != 0 {
+		return cmp.Compare(a.inf, b.inf)
+	}
+	if c := cmp.Compare(a.v, b.v); c != 0 {
+		return c
+	}
+	switch {
+	case a.after == b.after:
+		return 0
+	case b.after:
+		return -1
+	}
+	return 1
+}
+
+// between returns the interval between boundary a and boundary b.
+func between[T cmp.Ordered](a, b boundary[T]) Interval[T] {
+	return Interval[T]{
+		Begin:          a.v,
+		IncBegin:       !a.after,
+		UnboundedBegin: a.inf < 0,
+		End:            b.v,
+		IncEnd:         b.after,
+		UnboundedEnd:   b.inf > 0,
+	}
+}
+
+// sweepCursor iterates over the boundaries of the intervals of a set, the
+// depth increases at the begin and decreases at the end of an interval.
+type sweepCursor[T cmp.Ordered] struct {
+	intervals []Interval[T]
+	end       bool
+}
+
+func (c *sweepCursor[T]) boundary() boundary[T] {
+	i := c.intervals[0]
+	if c.end {
+		return boundary[T]{v: i.End, after: i.IncEnd}
+	}
+	if i.UnboundedBegin {
+		return boundary[T]{inf: -1}
+	}
+	return boundary[T]{v: i.Begin, after: !i.IncBegin}
+}
+
+func (c *sweepCursor[T]) delta() int {
+	if c.end {
+		return -1
+	}
+	return 1
+}
+
+// next moves to the next boundary, it returns false if there is none.
+func (c *sweepCursor[T]) next() bool {
+	if !c.end && !c.intervals[0].UnboundedEnd {
+		c.end = true
+		return true
+	}
+	c.intervals, c.end = c.intervals[1:], false
+	return len(c.intervals) > 0
+}
+
+type sweepHeap[T cmp.Ordered] []*sweepCursor[T]
+
+func (h sweepHeap[T]) Len() int { return len(h) }
+func (h sweepHeap[T]) Less(i, j int) bool {
+	return compareBoundary(h[i].boundary(), h[j].boundary()) < 0
+}
+func (h sweepHeap[T]) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *sweepHeap[T]) Push(x any)   { *h = append(*h, x.(*sweepCursor[T])) }
+func (h *sweepHeap[T]) Pop() any {
+	old := *h
+	c := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return c
+}
+
+// sweep returns an ordered set containing the points where the depth of
+// coverage by sets satisfies keep. It merges the boundaries of all sets
+// with a heap in O(n log k) for n intervals in k sets.
+func sweep[T cmp.Ordered](sets []OrderedSet[T], keep func(depth int) bool) OrderedSet[T] {
+	h := make(sweepHeap[T], 0, len(sets))
+	for _, s := range sets {
+		if len(s.intervals) > 0 {
+			h = append(h, &sweepCursor[T]{intervals: s.intervals})
+		}
+	}
+	heap.Init(&h)
+
+	var intervals []Interval[T]
+	prev, depth := boundary[T]{inf: -1}, 0
+	for len(h) > 0 {
+		b := h[0].boundary()
+		if keep(depth) && compareBoundary(prev, b) < 0 {
+			intervals = mergeOrAppend(intervals, between(prev, b))
+		}
+		for len(h) > 0 && compareBoundary(h[0].boundary(), b) == 0 {
+			depth += h[0].delta()
+			if h[0].next() {
+				heap.Fix(&h, 0)
+			} else {
+				heap.Pop(&h)
+			}
+		}
+		prev = b
+	}
+	if keep(depth) {
+		intervals = mergeOrAppend(intervals, between(prev, boundary[T]{inf: 1}))
+	}
+	return OrderedSet[T]{intervals: intervals}
+}
@@ -0,0 +1,102 @@
+package interval
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestAtLeast(t *testing.T) {
+	var atLeastCases = []struct {
+		sets []string
+		k    int
+		w    string
+	}{
+		{ // 0
+			sets: nil,
+			k:    1,
+			w:    "",
+		},
+		{ // 1
+			sets: []string{"====", "  ====", "     ===="},
+			k:    2,
+			w:    "  == =",
+		},
+		{ // 2
+			sets: []string{"====", "  ====", "   ===="},
+			k:    3,
+			w:    "   =",
+		},
+		{ // 3
+			sets: []string{"===*", "   ==*", "  *==="},
+			k:    2,
+			w:    "  *==*",
+		},
+		{ // 4
+			sets: []string{"<=  ==", " ====>", "=   ==>"},
+			k:    3,
+			w:    "    ==",
+		},
+		{ // 5
+			sets: []string{"=="},
+			k:    0,
+			w:    "<>",
+		},
+	}
+	for n, tc := range atLeastCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			var sets []OrderedSet[int]
+			for _, s := range tc.sets {
+				sets = append(sets, parseOrderedSet(s))
+			}
+			w := parseOrderedSet(tc.w)
+			if s := AtLeast(tc.k, sets...); !s.Equal(w) {
+				t.Errorf("want AtLeast(%d, %v) = %s but get %s", tc.k, sets, w, s)
+			}
+		})
+	}
+}
+
+func TestSweep_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randSet := func() OrderedSet[int] {
+		var s OrderedSet[int]
+		for n := r.Intn(6); n > 0; n-- {
+			b := r.Intn(40)
+			x := Interval[int]{Begin: b, IncBegin: r.Intn(2) == 0, End: b + r.Intn(8), IncEnd: r.Intn(2) == 0}
+			x.UnboundedBegin = r.Intn(20) == 0
+			x.UnboundedEnd = r.Intn(20) == 0
+			s.Add(x)
+		}
+		return s
+	}
+	for n := 0; n < 1000; n++ {
+		sets := make([]OrderedSet[int], 1+r.Intn(5))
+		var ms Multiset[int]
+		for k := range sets {
+			sets[k] = randSet()
+			for _, i := range sets[k].intervals {
+				ms.Add(i)
+			}
+		}
+		union, intersect, difference := sets[0], sets[0], sets[0]
+		for _, s := range sets[1:] {
+			union = Union(union, s)
+			intersect = Intersect(intersect, s)
+			difference = Difference(difference, s)
+		}
+		if s := UnionAll(sets...); !s.Equal(union) {
+			t.Fatalf("want UnionAll(%v) = %s but get %s", sets, union, s)
+		}
+		if s := IntersectAll(sets...); !s.Equal(intersect) {
+			t.Fatalf("want IntersectAll(%v) = %s but get %s", sets, intersect, s)
+		}
+		if s := SymmetricDifferenceAll(sets...); !s.Equal(difference) {
+			t.Fatalf("want SymmetricDifferenceAll(%v) = %s but get %s", sets, difference, s)
+		}
+		k := 1 + r.Intn(len(sets))
+		if s, w := AtLeast(k, sets...), ms.AtLeast(k); !s.Equal(w) {
+			t.Fatalf("want AtLeast(%d, %v) = %s but get %s", k, sets, w, s)
+		}
+	}
+}
@@ -0,0 +1,328 @@
+package interval
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// TimeInterval is an interval of time.Time endpoints.
+// Endpoints are compared with Before and Equal, so the location and the
+// monotonic clock reading of a time are ignored.
+type TimeInterval struct {
+	// begin of this interval.
+	Begin time.Time
+	// if IncBegin is true, this interval is inclusive of the Begin point.
+	IncBegin bool
+	// if UnboundedBegin is true, this interval extends to -inf, Begin and
+	// IncBegin are ignored.
+	UnboundedBegin bool
+
+	// end of this interval.
+	End time.Time
+	// if IncEnd is true, this interval is inclusive of the End point.
+	IncEnd bool
+	// if UnboundedEnd is true, this interval extends to +inf, End and
+	// IncEnd are ignored.
+	UnboundedEnd bool
+}
+
+// timeKey is a time encoded as a string that sorts in the same order as
+// time.Time.Before: 8 bytes of big endian unix seconds with the sign bit
+// flipped followed by 4 bytes of big endian nanoseconds.
+type timeKey string
+
+func newTimeKey(t time.Time) timeKey {
+	var b [12]byte
+	binary.BigEndian.PutUint64(b[:8], uint64(t.Unix())^(1<<63))
+	binary.BigEndian.PutUint32(b[8:], uint32(t.Nanosecond()))
+	return timeKey(b[:])
+}
+
+func (k timeKey) time() time.Time {
+	sec := int64(binary.BigEndian.Uint64([]byte(k[:8])) ^ (1 << 63))
+	nsec := int64(binary.BigEndian.Uint32([]byte(k[8:])))
+	return time.Unix(sec, nsec).UTC()
+}
+
+func (i TimeInterval) key() Interval[timeKey] {
+	x := Interval[timeKey]{
+		IncBegin:       i.IncBegin,
+		UnboundedBegin: i.UnboundedBegin,
+		IncEnd:         i.IncEnd,
+		UnboundedEnd:   i.UnboundedEnd,
+	}
+	if !i.UnboundedBegin {
+		x.Begin = newTimeKey(i.Begin)
+	}
+	if !i.UnboundedEnd {
+		x.End = newTimeKey(i.End)
+	}
+	return x
+}
+
+func timeIntervalOf(x Interval[timeKey]) TimeInterval {
+	if x.IsEmpty() {
+		return TimeInterval{}
+	}
+	i := TimeInterval{
+		IncBegin:       x.IncBegin,
+		UnboundedBegin: x.UnboundedBegin,
+		IncEnd:         x.IncEnd,
+		UnboundedEnd:   x.UnboundedEnd,
+	}
+	if !x.UnboundedBegin {
+		i.Begin = x.Begin.time()
+	}
+	if !x.UnboundedEnd {
+		i.End = x.End.time()
+	}
+	return i
+}
+
+// String returns the interval in the form printed by Interval.String with
+// RFC 3339 endpoints, such as "[2024-01-01T00:00:00Z, 2024-01-02T00:00:00Z)".
+func (i TimeInterval) String() string {
+	var b strings.Builder
+	if i.UnboundedBegin {
+		b.WriteString("(-inf")
+	} else {
+		if i.IncBegin {
+			b.WriteByte('[')
+		} else {
+			b.WriteByte('(')
+		}
+		b.WriteString(i.Begin.Format(time.RFC3339Nano))
+	}
+	b.WriteString(", ")
+	if i.UnboundedEnd {
+		b.WriteString("+inf)")
+	} else {
+		b.WriteString(i.End.Format(time.RFC3339Nano))
+		if i.IncEnd {
+			b.WriteByte(']')
+		} else {
+			b.WriteByte(')')
+		}
+	}
+	return b.String()
+}
+
+// ISO8601 returns the interval in ISO 8601 notation with RFC 3339
+// endpoints, such as "2024-01-01T00:00:00Z/2024-01-02T00:00:00Z".
+// An unbounded side is written as "..". ISO 8601 intervals do not record
+// whether endpoints are inclusive, they are read back as [begin, end).
+func (i TimeInterval) ISO8601() string {
+	begin, end := "..", ".."
+	if !i.UnboundedBegin {
+		begin = i.Begin.Format(time.RFC3339Nano)
+	}
+	if !i.UnboundedEnd {
+		end = i.End.Format(time.RFC3339Nano)
+	}
+	return begin + "/" + end
+}
+
+// ParseTimeInterval parses an interval in the ISO 8601 notation returned
+// by ISO8601 into a [begin, end) interval.
+func ParseTimeInterval(s string) (TimeInterval, error) {
+	begin, end, ok := strings.Cut(strings.TrimSpace(s), "/")
+	if !ok {
+		return TimeInterval{}, fmt.Errorf("interval: parse %q: missing '/'", s)
+	}
+	var i TimeInterval
+	var err error
+	if begin == ".." {
+		i.UnboundedBegin = true
+	} else if i.Begin, err = time.Parse(time.RFC3339Nano, begin); err != nil {
+		return TimeInterval{}, err
+	}
+	i.IncBegin = !i.UnboundedBegin
+	if end == ".." {
+		i.UnboundedEnd = true
+	} else if i.End, err = time.Parse(time.RFC3339Nano, end); err != nil {
+		return TimeInterval{}, err
+	}
+	return i, nil
+}
+
+// Equal returns true if receiver interval is equals x interval.
+func (i TimeInterval) Equal(x TimeInterval) bool {
+	return i.key().Equal(x.key())
+}
+
+// IsEmpty returns true if receiver interval has no value.
+func (i TimeInterval) IsEmpty() bool {
+	return i.key().IsEmpty()
+}
+
+// Contains returns true if x interval is completely covered by receiver interval.
+func (i TimeInterval) Contains(x TimeInterval) bool {
+	return i.key().Contains(x.key())
+}
+
+// ContainsTime returns true if t is in receiver interval.
+func (i TimeInterval) ContainsTime(t time.Time) bool {
+	return i.Contains(TimeInterval{Begin: t, IncBegin: true, End: t, IncEnd: true})
+}
+
+// Intersect returns the intersection of receiver interval with x interval.
+func (i TimeInterval) Intersect(x TimeInterval) TimeInterval {
+	return timeIntervalOf(i.key().Intersect(x.key()))
+}
+
+// Duration returns the length of receiver interval, it is 0 for an empty
+// interval and saturates at the maximum time.Duration for unbounded or very
+// long intervals.
+func (i TimeInterval) Duration() time.Duration {
+	if i.IsEmpty() {
+		return 0
+	}
+	if i.UnboundedBegin || i.UnboundedEnd {
+		return math.MaxInt64
+	}
+	return i.End.Sub(i.Begin)
+}
+
+// Move returns an interval that adds duration d to begin and end of
+// receiver interval. Unbounded endpoints stay unbounded.
+func (i TimeInterval) Move(d time.Duration) TimeInterval {
+	if i.IsEmpty() {
+		return TimeInterval{}
+	}
+	if !i.UnboundedBegin {
+		i.Begin = i.Begin.Add(d)
+	}
+	if !i.UnboundedEnd {
+		i.End = i.End.Add(d)
+	}
+	return i
+}
+
+// TimeSet is a set of ordered and non-overlapping time intervals, it uses
+// the OrderedSet algorithms.
+// Intervals returned from a TimeSet are in UTC.
+type TimeSet struct {
+	set OrderedSet[timeKey]
+}
+
+// Copy returns a copy of a time set that without affecting the original.
+func (s TimeSet) Copy() TimeSet {
+	return TimeSet{s.set.Copy()}
+}
+
+// Len returns length of intervals in this time set.
+func (s TimeSet) Len() int {
+	return s.set.Len()
+}
+
+// IsEmpty returns true if no intervals in this time set.
+func (s TimeSet) IsEmpty() bool {
+	return s.set.IsEmpty()
+}
+
+func (s TimeSet) Equal(x TimeSet) bool {
+	return s.set.Equal(x.set)
+}
+
+func (s TimeSet) String() string {
+	var b strings.Builder
+	b.WriteByte('{')
+	for n, i := range s.set.intervals {
+		if n > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(timeIntervalOf(i).String())
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// Bound returns the interval defined by the minimum and maximum values of this time set.
+func (s TimeSet) Bound() TimeInterval {
+	return timeIntervalOf(s.set.Bound())
+}
+
+// Intervals returns a copy of intervals in this time set.
+func (s TimeSet) Intervals() []TimeInterval {
+	intervals := make([]TimeInterval, 0, s.set.Len())
+	for _, i := range s.set.intervals {
+		intervals = append(intervals, timeIntervalOf(i))
+	}
+	return intervals
+}
+
+// Duration returns the total length of intervals in this time set, it
+// saturates at the maximum time.Duration.
+func (s TimeSet) Duration() time.Duration {
+	var d time.Duration
+	for _, i := range s.set.intervals {
+		x := timeIntervalOf(i).Duration()
+		if d > math.MaxInt64-x {
+			return math.MaxInt64
+		}
+		d += x
+	}
+	return d
+}
+
+// Contains returns true if x interval is completely covered by this time set.
+func (s TimeSet) Contains(x TimeInterval) bool {
+	return s.set.Contains(x.key())
+}
+
+// ContainsTime returns true if t is in this time set.
+func (s TimeSet) ContainsTime(t time.Time) bool {
+	return s.Contains(TimeInterval{Begin: t, IncBegin: true, End: t, IncEnd: true})
+}
+
+// Add adds x interval to this time set.
+// Add returns true if this time set changed.
+func (s *TimeSet) Add(x TimeInterval) bool {
+	return s.set.Add(x.key())
+}
+
+// Remove removes x interval from this time set.
+// Remove returns true if this time set changed.
+func (s *TimeSet) Remove(x TimeInterval) bool {
+	return s.set.Remove(x.key())
+}
+
+// Move returns a time set that adds duration d to every interval of this
+// time set.
+func (s TimeSet) Move(d time.Duration) TimeSet {
+	var x TimeSet
+	for _, i := range s.set.intervals {
+		x.set.intervals = append(x.set.intervals, timeIntervalOf(i).Move(d).key())
+	}
+	return x
+}
+
+// Union returns a time set containing all intervals in s or x.
+func (s TimeSet) Union(x TimeSet) TimeSet {
+	return TimeSet{Union(s.set, x.set)}
+}
+
+// Intersect returns a time set containing all intervals of s that also belong to x.
+func (s TimeSet) Intersect(x TimeSet) TimeSet {
+	return TimeSet{Intersect(s.set, x.set)}
+}
+
+// Subtract returns a time set containing all intervals in s but not in x.
+func (s TimeSet) Subtract(x TimeSet) TimeSet {
+	return TimeSet{Subtract(s.set, x.set)}
+}
+
+// Difference returns a time set containing all intervals in either of s and x,
+// but not in their intersection.
+func (s TimeSet) Difference(x TimeSet) TimeSet {
+	return TimeSet{Difference(s.set, x.set)}
+}
+
+// Complement returns a time set containing all intervals in universe but
+// not in s.
+func (s TimeSet) Complement(universe TimeInterval) TimeSet {
+	return TimeSet{Complement(s.set, universe.key())}
+}
@@ -0,0 +1,129 @@
+package interval
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func day(d int) time.Time {
+	return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestTimeInterval(t *testing.T) {
+	shanghai := time.FixedZone("CST", 8*60*60)
+	i := TimeInterval{Begin: day(1), IncBegin: true, End: day(3)}
+	x := TimeInterval{Begin: day(2).In(shanghai), IncBegin: true, End: day(10), IncEnd: true}
+
+	if d := i.Duration(); d != 48*time.Hour {
+		t.Errorf("want %s.Duration() = 48h but get %s", i, d)
+	}
+	if !i.ContainsTime(day(2).In(shanghai)) {
+		t.Errorf("want %s contains %s", i, day(2).In(shanghai))
+	}
+	if i.ContainsTime(day(3)) {
+		t.Errorf("want %s does not contain %s", i, day(3))
+	}
+	w := TimeInterval{Begin: day(2), IncBegin: true, End: day(3)}
+	if in := i.Intersect(x); !in.Equal(w) {
+		t.Errorf("want %s.Intersect(%s) = %s but get %s", i, x, w, in)
+	}
+	w = TimeInterval{Begin: day(2), IncBegin: true, End: day(4)}
+	if m := i.Move(24 * time.Hour); !m.Equal(w) {
+		t.Errorf("want %s.Move(24h) = %s but get %s", i, w, m)
+	}
+	if u := (TimeInterval{UnboundedBegin: true, End: day(1)}); u.Duration() != 1<<63-1 {
+		t.Errorf("want %s.Duration() saturates but get %s", u, u.Duration())
+	}
+}
+
+func TestTimeInterval_ISO8601(t *testing.T) {
+	var isoCases = []struct {
+		s string
+		w TimeInterval
+	}{
+		{ // 0
+			s: "2024-01-01T00:00:00Z/2024-01-02T00:00:00Z",
+			w: TimeInterval{Begin: day(1), IncBegin: true, End: day(2)},
+		},
+		{ // 1
+			s: "2024-01-01T00:00:00.5Z/..",
+			w: TimeInterval{Begin: day(1).Add(time.Second / 2), IncBegin: true, UnboundedEnd: true},
+		},
+		{ // 2
+			s: "../2024-01-02T08:00:00+08:00",
+			w: TimeInterval{UnboundedBegin: true, End: day(2)},
+		},
+	}
+	for n, tc := range isoCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			i, err := ParseTimeInterval(tc.s)
+			if err != nil {
+				t.Fatalf("want ParseTimeInterval(%q) succeeds but get %v", tc.s, err)
+			}
+			if !i.Equal(tc.w) {
+				t.Errorf("want ParseTimeInterval(%q) = %s but get %s", tc.s, tc.w, i)
+			}
+			r, err := ParseTimeInterval(i.ISO8601())
+			if err != nil || !r.Equal(i) {
+				t.Errorf("want ParseTimeInterval(%q) = %s but get %s, %v", i.ISO8601(), i, r, err)
+			}
+		})
+	}
+
+	for _, s := range []string{"2024-01-01T00:00:00Z", "2024-01-01/2024-01-02"} {
+		if i, err := ParseTimeInterval(s); err == nil {
+			t.Errorf("want ParseTimeInterval(%q) fails but get %s", s, i)
+		}
+	}
+}
+
+func TestTimeSet(t *testing.T) {
+	var a, b TimeSet
+	a.Add(TimeInterval{Begin: day(1), IncBegin: true, End: day(3)})
+	a.Add(TimeInterval{Begin: day(5), IncBegin: true, End: day(7)})
+	b.Add(TimeInterval{Begin: day(2), IncBegin: true, End: day(6)})
+
+	if w := "{[2024-01-01T00:00:00Z, 2024-01-03T00:00:00Z), [2024-01-05T00:00:00Z, 2024-01-07T00:00:00Z)}"; a.String() != w {
+		t.Errorf("want String() = %s but get %s", w, a)
+	}
+	if d := a.Duration(); d != 96*time.Hour {
+		t.Errorf("want %s.Duration() = 96h but get %s", a, d)
+	}
+
+	var w TimeSet
+	w.Add(TimeInterval{Begin: day(1), IncBegin: true, End: day(7)})
+	if u := a.Union(b); !u.Equal(w) {
+		t.Errorf("want %s.Union(%s) = %s but get %s", a, b, w, u)
+	}
+
+	w = TimeSet{}
+	w.Add(TimeInterval{Begin: day(2), IncBegin: true, End: day(3)})
+	w.Add(TimeInterval{Begin: day(5), IncBegin: true, End: day(6)})
+	if in := a.Intersect(b); !in.Equal(w) {
+		t.Errorf("want %s.Intersect(%s) = %s but get %s", a, b, w, in)
+	}
+	if !a.ContainsTime(day(2)) || a.ContainsTime(day(4)) {
+		t.Errorf("want %s contains %s but not %s", a, day(2), day(4))
+	}
+
+	w = TimeSet{}
+	w.Add(TimeInterval{Begin: day(3), IncBegin: true, End: day(5)})
+	if c := a.Complement(a.Bound()); !c.Equal(w) {
+		t.Errorf("want %s.Complement(%s) = %s but get %s", a, a.Bound(), w, c)
+	}
+
+	w = TimeSet{}
+	w.Add(TimeInterval{Begin: day(2), IncBegin: true, End: day(4)})
+	w.Add(TimeInterval{Begin: day(6), IncBegin: true, End: day(8)})
+	if m := a.Move(24 * time.Hour); !m.Equal(w) {
+		t.Errorf("want %s.Move(24h) = %s but get %s", a, w, m)
+	}
+
+	zero := TimeInterval{Begin: time.Time{}, IncBegin: true, End: time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)}
+	var z TimeSet
+	z.Add(zero)
+	if !z.Contains(a.Bound()) || !z.Intervals()[0].Equal(zero) {
+		t.Errorf("want %s contains %s", z, a.Bound())
+	}
+}
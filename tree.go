@@ -0,0 +1,213 @@
+package interval
+
+import (
+	"cmp"
+)
+
+// Tree is an augmented interval tree, unlike OrderedSet it keeps every
+// inserted interval as is, including duplicates and overlapping intervals.
+// Insert and Delete are O(log n), stabbing and overlap queries are
+// O(log n + k) where k is the number of reported intervals.
+type Tree[T cmp.Ordered] struct {
+	root *treeNode[T]
+	len  int
+}
+
+// treeNode is a node of an AVL tree ordered by begin and then by end of
+// intervals, maxEnd is the interval with the greatest end in the subtree.
+type treeNode[T cmp.Ordered] struct {
+	interval    Interval[T]
+	maxEnd      Interval[T]
+	height      int
+	left, right *treeNode[T]
+}
+
+// compareInterval orders intervals by begin and then by end.
+func compareInterval[T cmp.Ordered](i, x Interval[T]) int {
+	if c := compareBegin(i, x); c != 0 {
+		return c
+	}
+	return compareEnd(i, x)
+}
+
+// Len returns the number of intervals in this tree.
+func (t Tree[T]) Len() int {
+	return t.len
+}
+
+// Insert inserts x interval into this tree.
+// Insert returns false if x is empty.
+func (t *Tree[T]) Insert(x Interval[T]) bool {
+	if x.IsEmpty() {
+		return false
+	}
+	t.root = t.root.insert(x)
+	t.len++
+	return true
+}
+
+// Delete deletes one interval equal to x from this tree.
+// Delete returns true if this tree changed.
+func (t *Tree[T]) Delete(x Interval[T]) bool {
+	if x.IsEmpty() {
+		return false
+	}
+	var deleted bool
+	t.root, deleted = t.root.delete(x)
+	if deleted {
+		t.len--
+	}
+	return deleted
+}
+
+// Intervals returns all intervals in this tree ordered by begin and then
+// by end.
+func (t Tree[T]) Intervals() []Interval[T] {
+	intervals := make([]Interval[T], 0, t.len)
+	var walk func(n *treeNode[T])
+	walk = func(n *treeNode[T]) {
+		if n == nil {
+			return
+		}
+		walk(n.left)
+		intervals = append(intervals, n.interval)
+		walk(n.right)
+	}
+	walk(t.root)
+	return intervals
+}
+
+// Stab returns all intervals in this tree that contain point p, ordered by
+// begin and then by end.
+func (t Tree[T]) Stab(p T) []Interval[T] {
+	return t.Overlap(point(p))
+}
+
+// Overlap returns all intervals in this tree that intersect x interval,
+// ordered by begin and then by end.
+func (t Tree[T]) Overlap(x Interval[T]) []Interval[T] {
+	var intervals []Interval[T]
+	if x.IsEmpty() {
+		return intervals
+	}
+	var visit func(n *treeNode[T])
+	visit = func(n *treeNode[T]) {
+		// every interval in this subtree ends before x.
+		if n == nil || n.maxEnd.LtBeginOf(x) {
+			return
+		}
+		visit(n.left)
+		// this interval and every interval in the right subtree begin after x.
+		if x.LtBeginOf(n.interval) {
+			return
+		}
+		if !n.interval.Intersect(x).IsEmpty() {
+			intervals = append(intervals, n.interval)
+		}
+		visit(n.right)
+	}
+	visit(t.root)
+	return intervals
+}
+
+func (n *treeNode[T]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *treeNode[T]) update() {
+	n.height = 1 + max(n.left.getHeight(), n.right.getHeight())
+	n.maxEnd = n.interval
+	if n.left != nil && compareEnd(n.left.maxEnd, n.maxEnd) > 0 {
+		n.maxEnd = n.left.maxEnd
+	}
+	if n.right != nil && compareEnd(n.right.maxEnd, n.maxEnd) > 0 {
+		n.maxEnd = n.right.maxEnd
+	}
+}
+
+func (n *treeNode[T]) rotateLeft() *treeNode[T] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	n.update()
+	r.update()
+	return r
+}
+
+func (n *treeNode[T]) rotateRight() *treeNode[T] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	n.update()
+	l.update()
+	return l
+}
+
+func (n *treeNode[T]) balance() *treeNode[T] {
+	n.update()
+	switch d := n.left.getHeight() - n.right.getHeight(); {
+	case d > 1:
+		if n.left.left.getHeight() < n.left.right.getHeight() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case d < -1:
+		if n.right.right.getHeight() < n.right.left.getHeight() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
+
+func (n *treeNode[T]) insert(x Interval[T]) *treeNode[T] {
+	if n == nil {
+		return &treeNode[T]{interval: x, maxEnd: x, height: 1}
+	}
+	if compareInterval(x, n.interval) < 0 {
+		n.left = n.left.insert(x)
+	} else {
+		n.right = n.right.insert(x)
+	}
+	return n.balance()
+}
+
+func (n *treeNode[T]) delete(x Interval[T]) (*treeNode[T], bool) {
+	if n == nil {
+		return nil, false
+	}
+	var deleted bool
+	switch c := compareInterval(x, n.interval); {
+	case c < 0:
+		n.left, deleted = n.left.delete(x)
+	case c > 0:
+		n.right, deleted = n.right.delete(x)
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+		var successor *treeNode[T]
+		n.right, successor = n.right.deleteMin()
+		successor.left, successor.right = n.left, n.right
+		return successor.balance(), true
+	}
+	if !deleted {
+		return n, false
+	}
+	return n.balance(), true
+}
+
+func (n *treeNode[T]) deleteMin() (*treeNode[T], *treeNode[T]) {
+	if n.left == nil {
+		return n.right, n
+	}
+	var successor *treeNode[T]
+	n.left, successor = n.left.deleteMin()
+	return n.balance(), successor
+}
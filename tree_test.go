@@ -0,0 +1,137 @@
+package interval
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestTree(t *testing.T) {
+	var treeCases = []struct {
+		t []string
+		q string
+		w []string
+	}{
+		{ // 0
+			t: nil,
+			q: "=====",
+			w: nil,
+		},
+		{ // 1
+			t: []string{"===", "===", "  ====", "      *==*"},
+			q: "  =",
+			w: []string{"===", "===", "  ===="},
+		},
+		{ // 2
+			t: []string{"===", "===", "  ====", "      *==*"},
+			q: "    *==",
+			w: []string{"  ===="},
+		},
+		{ // 3
+			t: []string{"===", "===", "  ====", "      *==*"},
+			q: "   *==*",
+			w: []string{"  ===="},
+		},
+		{ // 4
+			t: []string{"<==", "  ====", "      *=>"},
+			q: "   *=====",
+			w: []string{"  ====", "      *=>"},
+		},
+		{ // 5
+			t: []string{"<==", "  ====", "      *=>"},
+			q: "<>",
+			w: []string{"<==", "  ====", "      *=>"},
+		},
+	}
+	for n, tc := range treeCases {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			var tree Tree[int]
+			for _, i := range tc.t {
+				tree.Insert(parseInterval(i))
+			}
+			if tree.Len() != len(tc.t) {
+				t.Errorf("want Len() = %d but get %d", len(tc.t), tree.Len())
+			}
+			q := parseInterval(tc.q)
+			var w []Interval[int]
+			for _, i := range tc.w {
+				w = append(w, parseInterval(i))
+			}
+			if o := tree.Overlap(q); !equalIntervals(o, w) {
+				t.Errorf("want Overlap(%s) = %s but get %s", q, w, o)
+			}
+		})
+	}
+}
+
+func TestTree_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randInterval := func() Interval[int] {
+		b := r.Intn(100)
+		return Interval[int]{Begin: b, IncBegin: r.Intn(2) == 0, End: b + r.Intn(20), IncEnd: r.Intn(2) == 0}
+	}
+
+	var tree Tree[int]
+	var all []Interval[int]
+	for n := 0; n < 2000; n++ {
+		if len(all) > 0 && r.Intn(3) == 0 {
+			k := r.Intn(len(all))
+			if !tree.Delete(all[k]) {
+				t.Fatalf("want Delete(%s) = true", all[k])
+			}
+			all = append(all[:k], all[k+1:]...)
+			if tree.Delete(Interval[int]{Begin: 1000, IncBegin: true, End: 1001}) {
+				t.Fatalf("want Delete of missing interval = false")
+			}
+		} else {
+			x := randInterval()
+			if tree.Insert(x) {
+				all = append(all, x)
+			}
+		}
+		if tree.Len() != len(all) {
+			t.Fatalf("want Len() = %d but get %d", len(all), tree.Len())
+		}
+		checkTreeNode(t, tree.root)
+
+		q := randInterval()
+		var w int
+		for _, i := range all {
+			if !i.Intersect(q).IsEmpty() {
+				w++
+			}
+		}
+		o := tree.Overlap(q)
+		if len(o) != w {
+			t.Fatalf("want Overlap(%s) returns %d intervals but get %d", q, w, len(o))
+		}
+		for _, i := range o {
+			if i.Intersect(q).IsEmpty() {
+				t.Fatalf("want Overlap(%s) intervals intersect but get %s", q, i)
+			}
+		}
+		p := r.Intn(120)
+		for _, i := range tree.Stab(p) {
+			if !i.Contains(Interval[int]{Begin: p, IncBegin: true, End: p, IncEnd: true}) {
+				t.Fatalf("want Stab(%d) intervals contain it but get %s", p, i)
+			}
+		}
+	}
+}
+
+func checkTreeNode(t *testing.T, n *treeNode[int]) int {
+	if n == nil {
+		return 0
+	}
+	l, r := checkTreeNode(t, n.left), checkTreeNode(t, n.right)
+	if d := l - r; d > 1 || d < -1 {
+		t.Fatalf("want balanced node %s but get heights %d, %d", n.interval, l, r)
+	}
+	if n.left != nil && compareInterval(n.left.interval, n.interval) > 0 {
+		t.Fatalf("want %s before %s", n.left.interval, n.interval)
+	}
+	if n.right != nil && compareInterval(n.right.interval, n.interval) < 0 {
+		t.Fatalf("want %s after %s", n.right.interval, n.interval)
+	}
+	return n.height
+}
@@ -0,0 +1,226 @@
+package interval
+
+import (
+	"cmp"
+	"iter"
+)
+
+// TreeSet is a set of ordered and non-overlapping intervals like
+// OrderedSet, but it keeps intervals in a balanced tree, so Add and Remove
+// are O(log n + k) where k is the number of intervals merged or split,
+// instead of O(n) for large sets.
+type TreeSet[T cmp.Ordered] struct {
+	// root shares the balanced tree of Tree. TreeSet does not need the
+	// maxEnd of the nodes, but keeping it costs a comparison per node on
+	// the rebalanced path, which is cheaper than a second copy of the
+	// rotations.
+	root *treeNode[T]
+	len  int
+}
+
+// Copy returns a copy of a tree set that without affecting the original.
+func (s TreeSet[T]) Copy() TreeSet[T] {
+	return TreeSet[T]{root: s.root.clone(), len: s.len}
+}
+
+func (n *treeNode[T]) clone() *treeNode[T] {
+	if n == nil {
+		return nil
+	}
+	c := *n
+	c.left, c.right = n.left.clone(), n.right.clone()
+	return &c
+}
+
+// Len returns length of intervals in this tree set.
+func (s TreeSet[T]) Len() int {
+	return s.len
+}
+
+// IsEmpty returns true if no intervals in this tree set.
+func (s TreeSet[T]) IsEmpty() bool {
+	return s.len == 0
+}
+
+func (s TreeSet[T]) Equal(x TreeSet[T]) bool {
+	return equalIntervals(s.Intervals(), x.Intervals())
+}
+
+func (s TreeSet[T]) String() string {
+	return s.OrderedSet().String()
+}
+
+// OrderedSet returns an ordered set containing the intervals of this tree set.
+func (s TreeSet[T]) OrderedSet() OrderedSet[T] {
+	return OrderedSet[T]{intervals: s.Intervals()}
+}
+
+// Intervals returns a copy of intervals in this tree set.
+func (s TreeSet[T]) Intervals() []Interval[T] {
+	intervals := make([]Interval[T], 0, s.len)
+	for x := range s.All() {
+		intervals = append(intervals, x)
+	}
+	return intervals
+}
+
+// All returns an iterator over all intervals in this tree set from left to
+// right.
+func (s TreeSet[T]) All() iter.Seq[Interval[T]] {
+	return func(yield func(Interval[T]) bool) {
+		var walk func(n *treeNode[T]) bool
+		walk = func(n *treeNode[T]) bool {
+			return n == nil ||
+				walk(n.left) && yield(n.interval) && walk(n.right)
+		}
+		walk(s.root)
+	}
+}
+
+// Bound returns the Interval defined by the minimum and maximum values of this tree set.
+func (s TreeSet[T]) Bound() Interval[T] {
+	if s.root == nil {
+		return Interval[T]{}
+	}
+	first, last := s.root, s.root
+	for first.left != nil {
+		first = first.left
+	}
+	for last.right != nil {
+		last = last.right
+	}
+	return first.interval.Encompass(last.interval)
+}
+
+// Contains returns true if x interval is completely covered by this tree set.
+func (s TreeSet[T]) Contains(x Interval[T]) bool {
+	var low *treeNode[T]
+	for n := s.root; n != nil; {
+		if n.interval.LtBeginOf(x) {
+			n = n.right
+		} else {
+			low, n = n, n.left
+		}
+	}
+	return low != nil && low.interval.Contains(x)
+}
+
+// Iterator returns a iterator that iterates over all the intervals both in
+// this tree set and bound.
+// If iterator returns empty Interval, the iteration is over.
+// If forward is true, the iteration from left to right.
+// The tree is walked lazily with a stack of O(log n) nodes, so the
+// intervals are not collected up front.
+func (s TreeSet[T]) Iterator(bound Interval[T], forward bool) func() Interval[T] {
+	if bound.IsEmpty() {
+		return emptyIterator[T]
+	}
+	// stack holds the path to the next interval, and the nodes above it
+	// that come after it in the direction of the iteration.
+	var stack []*treeNode[T]
+	// descend pushes n and its descendants towards the direction of the
+	// iteration, skipping the subtrees beyond the start of bound.
+	descend := func(n *treeNode[T]) {
+		for n != nil {
+			switch {
+			case forward && n.interval.LtBeginOf(bound):
+				n = n.right
+			case !forward && bound.LtBeginOf(n.interval):
+				n = n.left
+			case forward:
+				stack = append(stack, n)
+				n = n.left
+			default:
+				stack = append(stack, n)
+				n = n.right
+			}
+		}
+	}
+	descend(s.root)
+	return func() Interval[T] {
+		if len(stack) == 0 {
+			return Interval[T]{}
+		}
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		x := n.interval
+		if forward && bound.LtBeginOf(x) || !forward && x.LtBeginOf(bound) {
+			stack = nil
+			return Interval[T]{}
+		}
+		if forward {
+			descend(n.right)
+		} else {
+			descend(n.left)
+		}
+		return x
+	}
+}
+
+// touching returns the intervals in this tree set that intersect x, or are
+// adjacent to x if adjacent is true, from left to right.
+func (s TreeSet[T]) touching(x Interval[T], adjacent bool) []Interval[T] {
+	var intervals []Interval[T]
+	var visit func(n *treeNode[T])
+	visit = func(n *treeNode[T]) {
+		if n == nil {
+			return
+		}
+		i := n.interval
+		// i and every interval in the left subtree are before x.
+		if i.LtBeginOf(x) && (!adjacent || i.Adjoin(x).IsEmpty()) {
+			visit(n.right)
+			return
+		}
+		// i and every interval in the right subtree are after x.
+		if x.LtBeginOf(i) && (!adjacent || i.Adjoin(x).IsEmpty()) {
+			visit(n.left)
+			return
+		}
+		visit(n.left)
+		intervals = append(intervals, i)
+		visit(n.right)
+	}
+	visit(s.root)
+	return intervals
+}
+
+// Add adds x interval to this tree set.
+// Add returns true if this tree set changed.
+func (s *TreeSet[T]) Add(x Interval[T]) bool {
+	if x.IsEmpty() {
+		return false
+	}
+	touching := s.touching(x, true)
+	if len(touching) == 1 && touching[0].Contains(x) {
+		return false
+	}
+	for _, i := range touching {
+		s.root, _ = s.root.delete(i)
+		x = x.Encompass(i)
+	}
+	s.root = s.root.insert(x)
+	s.len += 1 - len(touching)
+	return true
+}
+
+// Remove removes x interval from this tree set.
+// Remove returns true if this tree set changed.
+func (s *TreeSet[T]) Remove(x Interval[T]) bool {
+	if x.IsEmpty() {
+		return false
+	}
+	touching := s.touching(x, false)
+	for _, i := range touching {
+		s.root, _ = s.root.delete(i)
+		s.len--
+		left, right := i.Bisect(x)
+		for _, r := range [2]Interval[T]{left, right} {
+			if !r.IsEmpty() {
+				s.root = s.root.insert(r)
+				s.len++
+			}
+		}
+	}
+	return len(touching) > 0
+}
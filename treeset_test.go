@@ -0,0 +1,174 @@
+package interval
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestTreeSet_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randInterval := func() Interval[int] {
+		b := r.Intn(200)
+		return Interval[int]{Begin: b, IncBegin: r.Intn(2) == 0, End: b + r.Intn(20), IncEnd: r.Intn(2) == 0}
+	}
+
+	var s OrderedSet[int]
+	var ts TreeSet[int]
+	for n := 0; n < 5000; n++ {
+		x := randInterval()
+		if r.Intn(3) == 0 {
+			if c, tc := s.Remove(x), ts.Remove(x); c != tc {
+				t.Fatalf("want %s.Remove(%s) = %t but get %t", s, x, c, tc)
+			}
+		} else {
+			if c, tc := s.Add(x), ts.Add(x); c != tc {
+				t.Fatalf("want %s.Add(%s) = %t but get %t", s, x, c, tc)
+			}
+		}
+		if !equalIntervals(ts.Intervals(), s.intervals) || ts.Len() != s.Len() {
+			t.Fatalf("want %s but get %s with length %d", s, ts, ts.Len())
+		}
+		checkTreeNode(t, ts.root)
+
+		q := randInterval()
+		if c, tc := s.Contains(q), ts.Contains(q); c != tc {
+			t.Fatalf("want %s.Contains(%s) = %t but get %t", s, q, c, tc)
+		}
+		if b, tb := s.Bound(), ts.Bound(); !b.Equal(tb) {
+			t.Fatalf("want %s.Bound() = %s but get %s", s, b, tb)
+		}
+		for _, forward := range []bool{true, false} {
+			it, tit := s.Iterator(q, forward), ts.Iterator(q, forward)
+			for {
+				x, tx := it(), tit()
+				if !x.Equal(tx) {
+					t.Fatalf("want %s.Iterator(%s, %t) yields %s but get %s", s, q, forward, x, tx)
+				}
+				if x.IsEmpty() {
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestTreeSet_Copy(t *testing.T) {
+	var s TreeSet[int]
+	s.Add(parseInterval("==="))
+	c := s.Copy()
+	c.Add(parseInterval("     ==="))
+	if s.Len() != 1 || c.Len() != 2 {
+		t.Errorf("want Copy() not affect the original but get %s and %s", s, c)
+	}
+	if w := "{[0, 2], [5, 7]}"; c.String() != w {
+		t.Errorf("want String() = %s but get %s", w, c)
+	}
+}
+
+// benchIntervals returns n disjoint intervals in random order.
+func benchIntervals(n int) []Interval[int] {
+	r := rand.New(rand.NewSource(1))
+	intervals := make([]Interval[int], n)
+	for i, k := range r.Perm(n) {
+		intervals[i] = Interval[int]{Begin: k * 4, IncBegin: true, End: k*4 + 2}
+	}
+	return intervals
+}
+
+func BenchmarkOrderedSet_Add(b *testing.B) {
+	for _, n := range []int{1000, 10000, 100000} {
+		intervals := benchIntervals(n)
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				var s OrderedSet[int]
+				for _, x := range intervals {
+					s.Add(x)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkTreeSet_Add(b *testing.B) {
+	for _, n := range []int{1000, 10000, 100000} {
+		intervals := benchIntervals(n)
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				var s TreeSet[int]
+				for _, x := range intervals {
+					s.Add(x)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkOrderedSet_Remove(b *testing.B) {
+	for _, n := range []int{1000, 10000, 100000} {
+		intervals := benchIntervals(n)
+		s := FromIntervals(intervals)
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				x := intervals[i%n]
+				s.Remove(x)
+				s.Add(x)
+			}
+		})
+	}
+}
+
+func BenchmarkTreeSet_Remove(b *testing.B) {
+	for _, n := range []int{1000, 10000, 100000} {
+		intervals := benchIntervals(n)
+		var s TreeSet[int]
+		for _, x := range intervals {
+			s.Add(x)
+		}
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				x := intervals[i%n]
+				s.Remove(x)
+				s.Add(x)
+			}
+		})
+	}
+}
+
+func BenchmarkOrderedSet_Contains(b *testing.B) {
+	intervals := benchIntervals(100000)
+	s := FromIntervals(intervals)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Contains(intervals[i%len(intervals)])
+	}
+}
+
+func BenchmarkTreeSet_Contains(b *testing.B) {
+	intervals := benchIntervals(100000)
+	var s TreeSet[int]
+	for _, x := range intervals {
+		s.Add(x)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Contains(intervals[i%len(intervals)])
+	}
+}
+
+func TestTreeSet_Iterator_Allocs(t *testing.T) {
+	var ts TreeSet[int]
+	for _, x := range benchIntervals(10000) {
+		ts.Add(x)
+	}
+	bound := Interval[int]{UnboundedBegin: true, UnboundedEnd: true}
+	allocs := testing.AllocsPerRun(10, func() {
+		it := ts.Iterator(bound, true)
+		for x := it(); !x.IsEmpty(); x = it() {
+		}
+	})
+	// the stack holds a path of the tree, not the intervals.
+	if allocs > 10 {
+		t.Errorf("want a few allocations but get %v", allocs)
+	}
+}